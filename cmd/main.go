@@ -1,19 +1,164 @@
-package main
-
-import (
-	"flag"
-	"log"
-
-	kvs "github.com/defoeam/herd/internal"
-)
-
-func main() {
-	useLogging := flag.Bool("useLogging", false, "Enable logging")
-	useSecurity := flag.Bool("useSecurity", false, "Enable security")
-
-	flag.Parse()
-
-	if err := kvs.StartGRPCServer(*useLogging, *useSecurity); err != nil {
-		log.Fatalf("Failed to start server: %v", err)
-	}
-}
+package main
+
+import (
+	"flag"
+	"fmt"
+	"log"
+	"math"
+	"os"
+	"time"
+
+	kvs "github.com/defoeam/herd/internal"
+)
+
+func main() {
+	// "herd recover" rebuilds the store offline instead of starting the server
+	if len(os.Args) > 1 && os.Args[1] == "recover" {
+		recoverSnapshot(os.Args[2:])
+		return
+	}
+
+	useLogging := flag.Bool("useLogging", false, "Enable logging")
+	useSecurity := flag.Bool("useSecurity", false, "Enable security")
+	maxMemory := flag.Int64("maxMemory", 0, "Memory limit for keys and values in bytes (0 for unlimited)")
+	evictionPolicy := flag.String("evictionPolicy", string(kvs.NoEviction),
+		"Eviction policy when maxMemory is reached (noeviction, allkeys-lru, allkeys-lfu, allkeys-random, volatile-ttl)")
+	durability := flag.String("durability", string(kvs.DurabilityEverySec),
+		"When the transaction log is fsynced (always, everysec, no)")
+	logBatchSize := flag.Int("logBatchSize", 256, "Most transaction log records grouped into a single write")
+	logFlushInterval := flag.Duration("logFlushInterval", 5*time.Millisecond,
+		"Longest a transaction log record waits for its batch to fill before it is written")
+	logQueueSize := flag.Int("logQueueSize", 4096, "Transaction log records that can be queued before writes are refused")
+	snapshotInterval := flag.Duration("snapshotInterval", 1*time.Hour, "How often a snapshot of the store is taken")
+	snapshotCompression := flag.String("snapshotCompression", string(kvs.CompressionZstd),
+		"How snapshot files are compressed (none, zstd, snappy)")
+	snapshotRetain := flag.Int("snapshotRetain", 2, "How many of the most recent snapshots are kept")
+	snapshotRetainFor := flag.Duration("snapshotRetainFor", 0,
+		"Also keep every snapshot taken within this long (0 to keep only the most recent ones)")
+	recoverToTime := flag.String("recoverToTime", "",
+		"Recover the store as it was at this RFC 3339 time instead of its latest state")
+	recoverToRevision := flag.Uint64("recoverToRevision", 0,
+		"Recover the store as it was at this revision instead of its latest state")
+	encryptionKeyFile := flag.String("encryptionKeyFile", "",
+		"File holding the keys that encrypt the transaction log and snapshots at rest")
+	encryptionKeyEnv := flag.String("encryptionKeyEnv", "",
+		"Environment variable holding the keys that encrypt the transaction log and snapshots at rest")
+	var indexes []kvs.IndexDefinition
+	flag.Func("index", "Secondary index on a field of JSON values, as name=path such as user_id=$.user_id (repeatable)",
+		func(s string) error {
+			def, err := kvs.ParseIndexDefinition(s)
+			indexes = append(indexes, def)
+			return err
+		})
+
+	flag.Parse()
+
+	policy, policyErr := kvs.ParseEvictionPolicy(*evictionPolicy)
+	if policyErr != nil {
+		log.Fatalf("Invalid configuration: %v", policyErr)
+	}
+
+	mode, modeErr := kvs.ParseDurabilityMode(*durability)
+	if modeErr != nil {
+		log.Fatalf("Invalid configuration: %v", modeErr)
+	}
+
+	compression, compressionErr := kvs.ParseSnapshotCompression(*snapshotCompression)
+	if compressionErr != nil {
+		log.Fatalf("Invalid configuration: %v", compressionErr)
+	}
+
+	target, targetErr := parseRecoveryTarget(*recoverToTime, *recoverToRevision)
+	if targetErr != nil {
+		log.Fatalf("Invalid configuration: %v", targetErr)
+	}
+
+	cfg := kvs.Config{
+		EnableLogging:  *useLogging,
+		EnableSecurity: *useSecurity,
+		MaxMemory:      *maxMemory,
+		EvictionPolicy: policy,
+		Log: kvs.LoggerOptions{
+			Durability:    mode,
+			QueueSize:     *logQueueSize,
+			BatchSize:     *logBatchSize,
+			FlushInterval: *logFlushInterval,
+		},
+		Snapshot: kvs.SnapshotOptions{
+			Interval:    *snapshotInterval,
+			Compression: compression,
+			Retain:      *snapshotRetain,
+			RetainFor:   *snapshotRetainFor,
+		},
+		RecoverTo: target,
+		Encryption: kvs.EncryptionOptions{
+			KeyFile: *encryptionKeyFile,
+			KeyEnv:  *encryptionKeyEnv,
+		},
+		Indexes: indexes,
+	}
+
+	if err := kvs.StartGRPCServer(cfg); err != nil {
+		log.Fatalf("Failed to start server: %v", err)
+	}
+}
+
+// recoverSnapshot writes a snapshot of the store as it was at a point in time, which
+// the server starts from the next time it is started.
+func recoverSnapshot(args []string) {
+	flags := flag.NewFlagSet("recover", flag.ExitOnError)
+	logFile := flags.String("logFile", kvs.LogFile, "Transaction log of the store to recover")
+	toTime := flags.String("toTime", "", "Recover the store as it was at this RFC 3339 time")
+	toRevision := flags.Uint64("toRevision", 0, "Recover the store as it was at this revision")
+	snapshotCompression := flags.String("snapshotCompression", string(kvs.CompressionZstd),
+		"How the snapshot file is compressed (none, zstd, snappy)")
+	encryptionKeyFile := flags.String("encryptionKeyFile", "", "File holding the keys the store is encrypted with")
+	encryptionKeyEnv := flags.String("encryptionKeyEnv", "", "Environment variable holding the keys the store is encrypted with")
+
+	if err := flags.Parse(args); err != nil {
+		log.Fatalf("Invalid configuration: %v", err)
+	}
+
+	compression, compressionErr := kvs.ParseSnapshotCompression(*snapshotCompression)
+	if compressionErr != nil {
+		log.Fatalf("Invalid configuration: %v", compressionErr)
+	}
+
+	target, targetErr := parseRecoveryTarget(*toTime, *toRevision)
+	if targetErr != nil {
+		log.Fatalf("Invalid configuration: %v", targetErr)
+	}
+	if target.IsZero() {
+		log.Fatalf("Invalid configuration: -toTime or -toRevision is required")
+	}
+
+	keys, keysErr := kvs.EncryptionOptions{KeyFile: *encryptionKeyFile, KeyEnv: *encryptionKeyEnv}.LoadKeyring()
+	if keysErr != nil {
+		log.Fatalf("Invalid configuration: %v", keysErr)
+	}
+
+	// Every existing snapshot is kept, so that the recovery can be redone with another target
+	info, err := kvs.RecoverSnapshot(*logFile,
+		kvs.LoggerOptions{Durability: kvs.DurabilityAlways, Keys: keys},
+		kvs.SnapshotOptions{Compression: compression, Retain: math.MaxInt},
+		target)
+	if err != nil {
+		log.Fatalf("Failed to recover: %v", err)
+	}
+
+	log.Printf("Wrote snapshot %s with %d keys", info.Name, info.Keys)
+}
+
+// parseRecoveryTarget builds a recovery target from the values of the recovery flags.
+func parseRecoveryTarget(toTime string, toRevision uint64) (kvs.RecoveryTarget, error) {
+	target := kvs.RecoveryTarget{Revision: toRevision}
+	if toTime != "" {
+		t, err := time.Parse(time.RFC3339Nano, toTime)
+		if err != nil {
+			return kvs.RecoveryTarget{}, fmt.Errorf("invalid recovery time: %w", err)
+		}
+		target.Time = t
+	}
+
+	return target, nil
+}
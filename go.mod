@@ -1,17 +1,19 @@
-module github.com/defoeam/herd
-
-go 1.22.7
-
-toolchain go1.23.3
-
-require (
-	google.golang.org/grpc v1.68.0
-	google.golang.org/protobuf v1.35.1
-)
-
-require (
-	golang.org/x/net v0.29.0 // indirect
-	golang.org/x/sys v0.25.0 // indirect
-	golang.org/x/text v0.18.0 // indirect
-	google.golang.org/genproto/googleapis/rpc v0.0.0-20240903143218-8af14fe29dc1 // indirect
-)
+module github.com/defoeam/herd
+
+go 1.22.7
+
+toolchain go1.23.3
+
+require (
+	github.com/google/btree v1.1.3
+	github.com/klauspost/compress v1.17.11
+	google.golang.org/genproto/googleapis/rpc v0.0.0-20240903143218-8af14fe29dc1
+	google.golang.org/grpc v1.68.0
+	google.golang.org/protobuf v1.35.1
+)
+
+require (
+	golang.org/x/net v0.29.0 // indirect
+	golang.org/x/sys v0.25.0 // indirect
+	golang.org/x/text v0.18.0 // indirect
+)
@@ -0,0 +1,59 @@
+package keyvaluestore
+
+import (
+	"log"
+	"time"
+)
+
+// MultiGet retrieves several keys under a single lock acquisition.
+// It returns one result per key, in the same order, with Found reporting whether the key exists.
+func (kv *KeyValueStore) MultiGet(keys []string) []TxnOpResult {
+	kv.mu.RLock()
+	defer kv.mu.RUnlock()
+
+	log.Printf("Get %d keys from kvs", len(keys))
+
+	now := time.Now()
+	results := make([]TxnOpResult, len(keys))
+	for i, key := range keys {
+		results[i] = TxnOpResult{Key: key}
+		if e, ok := kv.live(key, now); ok {
+			e.touch(now)
+			results[i] = TxnOpResult{Key: key, Value: e.value, ContentType: e.contentType, Version: e.version, Found: true}
+		}
+	}
+
+	return results
+}
+
+// MultiSet atomically sets every item, optionally expiring them after ttl.
+// The writes are logged as a single batch and share one version, which is returned.
+func (kv *KeyValueStore) MultiSet(items []KeyValue, ttl time.Duration) (uint64, error) {
+	ops := make([]TxnOp, len(items))
+	for i, item := range items {
+		ops[i] = TxnOp{Type: TxnSet, Key: item.Key, Value: item.Value, ContentType: item.ContentType, TTL: ttl}
+	}
+
+	result, err := kv.Txn(nil, ops, nil)
+	if err != nil {
+		return 0, err
+	}
+
+	return result.Revision, nil
+}
+
+// MultiDelete atomically deletes several keys, logging the deletions as a single batch.
+// It returns one result per key, in the same order, holding the deleted value if the key was found.
+func (kv *KeyValueStore) MultiDelete(keys []string) ([]TxnOpResult, error) {
+	ops := make([]TxnOp, len(keys))
+	for i, key := range keys {
+		ops[i] = TxnOp{Type: TxnDelete, Key: key}
+	}
+
+	result, err := kv.Txn(nil, ops, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return result.Results, nil
+}
@@ -0,0 +1,560 @@
+package keyvaluestore
+
+import (
+	"bytes"
+	"cmp"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"math"
+	"slices"
+	"time"
+)
+
+// The native data structures are stored as values tagged with these content types.
+// Every operation on one decodes the whole value and writes it back, so they are
+// meant for collections of up to a few thousand elements. Because the result of each
+// operation is logged and snapshotted like any other value, replay doesn't depend on
+// the previous state. A collection that becomes empty is deleted.
+const (
+	// ContentTypeList is the content type of lists of byte strings.
+	ContentTypeList = "application/vnd.herd.list"
+	// ContentTypeSet is the content type of sets of unique members.
+	ContentTypeSet = "application/vnd.herd.set"
+	// ContentTypeHash is the content type of hashes mapping fields to byte strings.
+	ContentTypeHash = "application/vnd.herd.hash"
+	// ContentTypeSortedSet is the content type of sets of unique members ordered by score.
+	ContentTypeSortedSet = "application/vnd.herd.zset"
+)
+
+// ScoredMember is a member of a sorted set.
+type ScoredMember struct {
+	Member string
+	Score  float64
+}
+
+// ListPush appends values to the list at key, or prepends them in order if front is
+// set, creating the list if key doesn't exist. It returns the length of the list.
+func (kv *KeyValueStore) ListPush(key string, values [][]byte, front bool) (int, error) {
+	if len(values) == 0 {
+		return 0, &KeyError{Key: key, Err: invalidField("values", "must not be empty")}
+	}
+
+	var length int
+	err := kv.updateCollection(key, ContentTypeList, func(value []byte) ([]byte, error) {
+		list, err := decodeList(value)
+		if err != nil {
+			return nil, err
+		}
+
+		if front {
+			list = append(slices.Clone(values), list...)
+		} else {
+			list = append(list, values...)
+		}
+		length = len(list)
+
+		return encodeList(list), nil
+	})
+
+	return length, err
+}
+
+// ListPop removes and returns up to count values from the end of the list at key, or
+// from the front if front is set. A count of zero pops a single value. Popping from a
+// key that doesn't exist returns no values.
+func (kv *KeyValueStore) ListPop(key string, count int, front bool) ([][]byte, error) {
+	if count < 0 {
+		return nil, &KeyError{Key: key, Err: invalidField("count", "must not be negative: %d", count)}
+	}
+	count = max(count, 1)
+
+	var popped [][]byte
+	err := kv.updateCollection(key, ContentTypeList, func(value []byte) ([]byte, error) {
+		list, err := decodeList(value)
+		if err != nil {
+			return nil, err
+		}
+
+		count = min(count, len(list))
+		if front {
+			popped, list = list[:count], list[count:]
+		} else {
+			popped, list = slices.Clone(list[len(list)-count:]), list[:len(list)-count]
+			slices.Reverse(popped)
+		}
+
+		return encodeList(list), nil
+	})
+
+	return popped, err
+}
+
+// ListRange returns the values of the list at key from start to stop, both inclusive.
+// Negative indexes count from the end of the list, so -1 is its last value.
+func (kv *KeyValueStore) ListRange(key string, start, stop int) ([][]byte, error) {
+	value, err := kv.getCollection(key, ContentTypeList)
+	if err != nil {
+		return nil, err
+	}
+
+	list, err := decodeList(value)
+	if err != nil {
+		return nil, &KeyError{Key: key, Err: err}
+	}
+
+	if start < 0 {
+		start = max(len(list)+start, 0)
+	}
+	if stop < 0 {
+		stop = len(list) + stop
+	}
+	stop = min(stop, len(list)-1)
+	if start > stop {
+		return nil, nil
+	}
+
+	return list[start : stop+1], nil
+}
+
+// SetAdd adds members to the set at key, creating the set if key doesn't exist.
+// It returns how many of the members weren't in the set yet.
+func (kv *KeyValueStore) SetAdd(key string, members []string) (int, error) {
+	if len(members) == 0 {
+		return 0, &KeyError{Key: key, Err: invalidField("members", "must not be empty")}
+	}
+
+	var added int
+	err := kv.updateCollection(key, ContentTypeSet, func(value []byte) ([]byte, error) {
+		set, err := decodeSet(value)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, member := range members {
+			if _, ok := set[member]; !ok {
+				set[member] = struct{}{}
+				added++
+			}
+		}
+
+		return encodeSet(set), nil
+	})
+
+	return added, err
+}
+
+// SetRemove removes members from the set at key and returns how many of them were in it.
+func (kv *KeyValueStore) SetRemove(key string, members []string) (int, error) {
+	var removed int
+	err := kv.updateCollection(key, ContentTypeSet, func(value []byte) ([]byte, error) {
+		set, err := decodeSet(value)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, member := range members {
+			if _, ok := set[member]; ok {
+				delete(set, member)
+				removed++
+			}
+		}
+
+		return encodeSet(set), nil
+	})
+
+	return removed, err
+}
+
+// SetMembers returns the members of the set at key in lexicographic order.
+func (kv *KeyValueStore) SetMembers(key string) ([]string, error) {
+	value, err := kv.getCollection(key, ContentTypeSet)
+	if err != nil {
+		return nil, err
+	}
+
+	set, err := decodeSet(value)
+	if err != nil {
+		return nil, &KeyError{Key: key, Err: err}
+	}
+
+	return sortedKeys(set), nil
+}
+
+// HashSet sets fields of the hash at key, creating the hash if key doesn't exist.
+// It returns how many of the fields weren't in the hash yet.
+func (kv *KeyValueStore) HashSet(key string, fields map[string][]byte) (int, error) {
+	if len(fields) == 0 {
+		return 0, &KeyError{Key: key, Err: invalidField("fields", "must not be empty")}
+	}
+
+	var added int
+	err := kv.updateCollection(key, ContentTypeHash, func(value []byte) ([]byte, error) {
+		hash, err := decodeHash(value)
+		if err != nil {
+			return nil, err
+		}
+
+		for field, fieldValue := range fields {
+			if _, ok := hash[field]; !ok {
+				added++
+			}
+			hash[field] = fieldValue
+		}
+
+		return encodeHash(hash), nil
+	})
+
+	return added, err
+}
+
+// HashGet returns the value of field in the hash at key, reporting whether the field exists.
+func (kv *KeyValueStore) HashGet(key, field string) ([]byte, bool, error) {
+	hash, err := kv.HashGetAll(key)
+	if err != nil {
+		return nil, false, err
+	}
+
+	value, ok := hash[field]
+	return value, ok, nil
+}
+
+// HashGetAll returns every field of the hash at key.
+func (kv *KeyValueStore) HashGetAll(key string) (map[string][]byte, error) {
+	value, err := kv.getCollection(key, ContentTypeHash)
+	if err != nil {
+		return nil, err
+	}
+
+	hash, err := decodeHash(value)
+	if err != nil {
+		return nil, &KeyError{Key: key, Err: err}
+	}
+
+	return hash, nil
+}
+
+// SortedSetAdd adds members to the sorted set at key, or updates their scores if
+// they are in it already, creating the sorted set if key doesn't exist. It returns
+// how many of the members weren't in the sorted set yet.
+func (kv *KeyValueStore) SortedSetAdd(key string, members []ScoredMember) (int, error) {
+	if len(members) == 0 {
+		return 0, &KeyError{Key: key, Err: invalidField("members", "must not be empty")}
+	}
+	for i, member := range members {
+		if math.IsNaN(member.Score) {
+			return 0, &KeyError{Key: key, Err: invalidField(fmt.Sprintf("members[%d].score", i), "must be a number")}
+		}
+	}
+
+	var added int
+	err := kv.updateCollection(key, ContentTypeSortedSet, func(value []byte) ([]byte, error) {
+		zset, err := decodeSortedSet(value)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, member := range members {
+			if _, ok := zset[member.Member]; !ok {
+				added++
+			}
+			zset[member.Member] = member.Score
+		}
+
+		return encodeSortedSet(zset), nil
+	})
+
+	return added, err
+}
+
+// SortedSetRangeByScore returns up to limit members of the sorted set at key with a
+// score between min and max, both inclusive, ordered by score and then by member.
+// A limit of zero returns every such member.
+func (kv *KeyValueStore) SortedSetRangeByScore(key string, minScore, maxScore float64, limit int) ([]ScoredMember, error) {
+	if limit < 0 {
+		return nil, &KeyError{Key: key, Err: invalidField("limit", "must not be negative: %d", limit)}
+	}
+
+	value, err := kv.getCollection(key, ContentTypeSortedSet)
+	if err != nil {
+		return nil, err
+	}
+
+	zset, err := decodeSortedSet(value)
+	if err != nil {
+		return nil, &KeyError{Key: key, Err: err}
+	}
+
+	var members []ScoredMember
+	for _, member := range sortedMembers(zset) {
+		if member.Score < minScore || member.Score > maxScore {
+			continue
+		}
+		if limit > 0 && len(members) == limit {
+			break
+		}
+		members = append(members, member)
+	}
+
+	return members, nil
+}
+
+// updateCollection atomically replaces the collection of contentType stored under key
+// with the one returned by fn, which is passed the encoded collection or nil if key
+// doesn't exist. The key keeps its TTL, and is deleted if fn returns an empty collection.
+func (kv *KeyValueStore) updateCollection(key, contentType string, fn func(value []byte) ([]byte, error)) error {
+	_, err := kv.update(key, func(current *entry, _ time.Time) (*entry, error) {
+		var value []byte
+		var expiresAt time.Time
+		if current != nil {
+			if err := checkType(current.contentType, contentType); err != nil {
+				return nil, err
+			}
+			value, expiresAt = current.value, current.expiresAt
+		}
+
+		updated, err := fn(value)
+		if err != nil {
+			return nil, err
+		}
+
+		switch {
+		case current != nil && bytes.Equal(updated, current.value):
+			return current, nil
+		case len(updated) == 0:
+			return nil, nil
+		default:
+			return newEntry(updated, contentType, expiresAt, 0), nil
+		}
+	})
+
+	return err
+}
+
+// getCollection returns the encoded collection of contentType stored under key, or
+// nil if key doesn't exist.
+func (kv *KeyValueStore) getCollection(key, contentType string) ([]byte, error) {
+	item, ok := kv.GetItem(key)
+	if !ok {
+		return nil, nil
+	}
+
+	if err := checkType(item.ContentType, contentType); err != nil {
+		return nil, &KeyError{Key: key, Err: err}
+	}
+
+	return item.Value, nil
+}
+
+// checkType returns ErrWrongType unless a value of contentType holds the data structure of want.
+func checkType(contentType, want string) error {
+	if contentType != want {
+		return fmt.Errorf("%w: the key holds %s, not %s", ErrWrongType, describeType(contentType), describeType(want))
+	}
+
+	return nil
+}
+
+// describeType names the data structure held by a value of contentType.
+func describeType(contentType string) string {
+	switch contentType {
+	case ContentTypeList:
+		return "a list"
+	case ContentTypeSet:
+		return "a set"
+	case ContentTypeHash:
+		return "a hash"
+	case ContentTypeSortedSet:
+		return "a sorted set"
+	default:
+		return "a plain value"
+	}
+}
+
+// isCollection reports whether a value of contentType holds a native data structure.
+func isCollection(contentType string) bool {
+	switch contentType {
+	case ContentTypeList, ContentTypeSet, ContentTypeHash, ContentTypeSortedSet:
+		return true
+	default:
+		return false
+	}
+}
+
+// validateCollection checks that value is a valid encoding of the data structure of
+// contentType. Values of other content types are always valid.
+func validateCollection(value []byte, contentType string) error {
+	var err error
+	switch contentType {
+	case ContentTypeList:
+		_, err = decodeList(value)
+	case ContentTypeSet:
+		_, err = decodeSet(value)
+	case ContentTypeHash:
+		_, err = decodeHash(value)
+	case ContentTypeSortedSet:
+		_, err = decodeSortedSet(value)
+	}
+
+	return err
+}
+
+// Collections are encoded as a sequence of elements, each preceded by its length as a
+// uvarint. Lists hold their values in order, sets their members, hashes their fields
+// each followed by its value, and sorted sets their members each followed by their
+// score as 8 big-endian bytes. Members and fields are written in order.
+
+// encodeList encodes the values of a list.
+func encodeList(list [][]byte) []byte {
+	var buf []byte
+	for _, value := range list {
+		buf = appendElement(buf, value)
+	}
+
+	return buf
+}
+
+// decodeList decodes a list encoded by encodeList.
+func decodeList(value []byte) ([][]byte, error) {
+	var list [][]byte
+	for len(value) > 0 {
+		element, rest, err := readElement(value)
+		if err != nil {
+			return nil, err
+		}
+		list, value = append(list, element), rest
+	}
+
+	return list, nil
+}
+
+// encodeSet encodes the members of a set.
+func encodeSet(set map[string]struct{}) []byte {
+	var buf []byte
+	for _, member := range sortedKeys(set) {
+		buf = appendElement(buf, []byte(member))
+	}
+
+	return buf
+}
+
+// decodeSet decodes a set encoded by encodeSet.
+func decodeSet(value []byte) (map[string]struct{}, error) {
+	members, err := decodeList(value)
+	if err != nil {
+		return nil, err
+	}
+
+	set := make(map[string]struct{}, len(members))
+	for _, member := range members {
+		set[string(member)] = struct{}{}
+	}
+
+	return set, nil
+}
+
+// encodeHash encodes the fields of a hash.
+func encodeHash(hash map[string][]byte) []byte {
+	var buf []byte
+	for _, field := range sortedKeys(hash) {
+		buf = appendElement(buf, []byte(field))
+		buf = appendElement(buf, hash[field])
+	}
+
+	return buf
+}
+
+// decodeHash decodes a hash encoded by encodeHash.
+func decodeHash(value []byte) (map[string][]byte, error) {
+	elements, err := decodeList(value)
+	if err != nil {
+		return nil, err
+	}
+	if len(elements)%2 != 0 {
+		return nil, errors.New("hash field without a value")
+	}
+
+	hash := make(map[string][]byte, len(elements)/2)
+	for i := 0; i < len(elements); i += 2 {
+		hash[string(elements[i])] = elements[i+1]
+	}
+
+	return hash, nil
+}
+
+// encodeSortedSet encodes the members of a sorted set.
+func encodeSortedSet(zset map[string]float64) []byte {
+	var buf []byte
+	for _, member := range sortedMembers(zset) {
+		buf = appendElement(buf, []byte(member.Member))
+		buf = appendElement(buf, binary.BigEndian.AppendUint64(nil, math.Float64bits(member.Score)))
+	}
+
+	return buf
+}
+
+// decodeSortedSet decodes a sorted set encoded by encodeSortedSet.
+func decodeSortedSet(value []byte) (map[string]float64, error) {
+	elements, err := decodeList(value)
+	if err != nil {
+		return nil, err
+	}
+	if len(elements)%2 != 0 {
+		return nil, errors.New("sorted set member without a score")
+	}
+
+	zset := make(map[string]float64, len(elements)/2)
+	for i := 0; i < len(elements); i += 2 {
+		if len(elements[i+1]) != 8 {
+			return nil, fmt.Errorf("score of %d bytes", len(elements[i+1]))
+		}
+		score := math.Float64frombits(binary.BigEndian.Uint64(elements[i+1]))
+		if math.IsNaN(score) {
+			return nil, errors.New("score is not a number")
+		}
+		zset[string(elements[i])] = score
+	}
+
+	return zset, nil
+}
+
+// sortedMembers returns the members of a sorted set ordered by score and then by member.
+func sortedMembers(zset map[string]float64) []ScoredMember {
+	members := make([]ScoredMember, 0, len(zset))
+	for member, score := range zset {
+		members = append(members, ScoredMember{Member: member, Score: score})
+	}
+	slices.SortFunc(members, func(a, b ScoredMember) int {
+		return cmp.Or(cmp.Compare(a.Score, b.Score), cmp.Compare(a.Member, b.Member))
+	})
+
+	return members
+}
+
+// sortedKeys returns the keys of m in lexicographic order.
+func sortedKeys[V any](m map[string]V) []string {
+	keys := make([]string, 0, len(m))
+	for key := range m {
+		keys = append(keys, key)
+	}
+	slices.Sort(keys)
+
+	return keys
+}
+
+// appendElement appends element to buf, preceded by its length.
+func appendElement(buf, element []byte) []byte {
+	buf = binary.AppendUvarint(buf, uint64(len(element)))
+	return append(buf, element...)
+}
+
+// readElement reads an element written by appendElement and returns it along with the rest of data.
+func readElement(data []byte) ([]byte, []byte, error) {
+	length, n := binary.Uvarint(data)
+	if n <= 0 || length > uint64(len(data)-n) {
+		return nil, nil, errors.New("truncated element")
+	}
+	end := n + int(length)
+
+	return data[n:end:end], data[end:], nil
+}
@@ -0,0 +1,67 @@
+package keyvaluestore
+
+import (
+	"encoding/json"
+	"fmt"
+	"mime"
+	"strings"
+)
+
+const (
+	// ContentTypeJSON is the content type of JSON values.
+	ContentTypeJSON = "application/json"
+
+	// maxContentTypeLength is the longest content type a value can be tagged with.
+	maxContentTypeLength = 255
+)
+
+// IsJSON reports whether contentType declares a JSON value: application/json or a
+// media type with the +json suffix, with any parameters.
+func IsJSON(contentType string) bool {
+	mediaType, _, err := mime.ParseMediaType(contentType)
+	if err != nil {
+		return false
+	}
+
+	return mediaType == ContentTypeJSON || strings.HasSuffix(mediaType, "+json")
+}
+
+// isText reports whether contentType declares a value that is safe to print.
+func isText(contentType string) bool {
+	return IsJSON(contentType) || strings.HasPrefix(contentType, "text/")
+}
+
+// validateValue checks value against its declared content type. Values without a
+// content type are opaque bytes and always valid, values declared as JSON must be
+// valid JSON and values declared as a native data structure must be encoded as one.
+// field prefixes the names of the fields reported in errors.
+func validateValue(field string, value []byte, contentType string) error {
+	if contentType == "" {
+		return nil
+	}
+
+	if len(contentType) > maxContentTypeLength {
+		return invalidField(field+"content_type", "must be at most %d bytes", maxContentTypeLength)
+	}
+	if _, _, err := mime.ParseMediaType(contentType); err != nil {
+		return invalidField(field+"content_type", "%v", err)
+	}
+
+	if IsJSON(contentType) && !json.Valid(value) {
+		return invalidField(field+"value", "is not valid JSON")
+	}
+	if err := validateCollection(value, contentType); err != nil {
+		return invalidField(field+"value", "is not %s: %v", describeType(contentType), err)
+	}
+
+	return nil
+}
+
+// formatValue describes value for the server log, printing it only if it is text.
+func formatValue(value []byte, contentType string) string {
+	if isText(contentType) {
+		return fmt.Sprintf("%q", value)
+	}
+
+	return fmt.Sprintf("%d bytes", len(value))
+}
@@ -0,0 +1,135 @@
+package keyvaluestore
+
+import (
+	"fmt"
+	"math"
+	"strconv"
+	"time"
+)
+
+// Number is an integer or a floating-point number held by a counter. Counters are
+// stored as their decimal text, so they can be read with Get like any other value.
+type Number struct {
+	Int   int64
+	Float float64
+	// IsFloat reports whether the number is Float rather than Int.
+	IsFloat bool
+}
+
+// IntNumber returns the integer i as a Number.
+func IntNumber(i int64) Number {
+	return Number{Int: i}
+}
+
+// FloatNumber returns the floating-point number f as a Number.
+func FloatNumber(f float64) Number {
+	return Number{Float: f, IsFloat: true}
+}
+
+// String returns the decimal text a counter holding n is stored as.
+func (n Number) String() string {
+	if n.IsFloat {
+		return strconv.FormatFloat(n.Float, 'f', -1, 64)
+	}
+
+	return strconv.FormatInt(n.Int, 10)
+}
+
+// IncrementOptions holds the optional settings of Increment and Decrement.
+type IncrementOptions struct {
+	// Initial is the value a key that doesn't exist starts from before the delta is
+	// applied, zero by default. It must be an integer when the delta is.
+	Initial Number
+	// TTL expires the key after the given duration if the increment creates it. The
+	// expiration of an existing key is kept, so a counter can count over a fixed window.
+	TTL time.Duration
+}
+
+// Increment atomically adds delta to the number stored under key and returns the
+// result along with the new item. An integer delta requires the stored
+// value to be an integer, while a floating-point delta accepts either and stores a
+// floating-point result. Only the result is logged, so replaying the log doesn't
+// depend on the previous value. It returns ErrNotNumber if the stored value isn't
+// a number, and ErrOverflow if the result can't be represented.
+func (kv *KeyValueStore) Increment(key string, delta Number, opts IncrementOptions) (Number, ScanItem, error) {
+	if delta.IsFloat && (math.IsNaN(delta.Float) || math.IsInf(delta.Float, 0)) {
+		return Number{}, ScanItem{}, &KeyError{Key: key, Err: invalidField("delta", "must be a finite number")}
+	}
+	if !delta.IsFloat && opts.Initial.IsFloat {
+		return Number{}, ScanItem{}, &KeyError{Key: key, Err: invalidField("initial", "must be an integer when the delta is")}
+	}
+
+	var result Number
+	e, err := kv.update(key, func(current *entry, now time.Time) (*entry, error) {
+		value, contentType, expiresAt := []byte(opts.Initial.String()), "", expiryFor(opts.TTL, now)
+		if current != nil {
+			if isCollection(current.contentType) {
+				return nil, fmt.Errorf("%w: the key holds %s, not a number", ErrWrongType, describeType(current.contentType))
+			}
+			value, contentType, expiresAt = current.value, current.contentType, current.expiresAt
+		}
+
+		n, err := parseNumber(value, delta.IsFloat)
+		if err != nil {
+			return nil, err
+		}
+		if result, err = n.add(delta); err != nil {
+			return nil, err
+		}
+
+		return newEntry([]byte(result.String()), contentType, expiresAt, 0), nil
+	})
+	if err != nil {
+		return Number{}, ScanItem{}, err
+	}
+
+	return result, e.item(key), nil
+}
+
+// Decrement atomically subtracts delta from the number stored under key, like
+// Increment with the opposite delta.
+func (kv *KeyValueStore) Decrement(key string, delta Number, opts IncrementOptions) (Number, ScanItem, error) {
+	if !delta.IsFloat && delta.Int == math.MinInt64 {
+		return Number{}, ScanItem{}, &KeyError{Key: key, Err: ErrOverflow}
+	}
+
+	delta.Int, delta.Float = -delta.Int, -delta.Float
+
+	return kv.Increment(key, delta, opts)
+}
+
+// parseNumber parses the number stored in value, as a floating-point number if asFloat is set.
+func parseNumber(value []byte, asFloat bool) (Number, error) {
+	if !asFloat {
+		i, err := strconv.ParseInt(string(value), 10, 64)
+		if err != nil {
+			return Number{}, fmt.Errorf("%w: value is not an integer", ErrNotNumber)
+		}
+		return IntNumber(i), nil
+	}
+
+	f, err := strconv.ParseFloat(string(value), 64)
+	if err != nil || math.IsNaN(f) || math.IsInf(f, 0) {
+		return Number{}, fmt.Errorf("%w: value is not a finite number", ErrNotNumber)
+	}
+
+	return FloatNumber(f), nil
+}
+
+// add returns n plus delta, which is of the same kind as n.
+func (n Number) add(delta Number) (Number, error) {
+	if n.IsFloat {
+		sum := n.Float + delta.Float
+		if math.IsInf(sum, 0) {
+			return Number{}, ErrOverflow
+		}
+		return FloatNumber(sum), nil
+	}
+
+	sum := n.Int + delta.Int
+	if (delta.Int > 0 && sum < n.Int) || (delta.Int < 0 && sum > n.Int) {
+		return Number{}, ErrOverflow
+	}
+
+	return IntNumber(sum), nil
+}
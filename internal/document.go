@@ -0,0 +1,514 @@
+package keyvaluestore
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// GetPath returns the part of the JSON value of key selected by path, encoded as
+// JSON, along with the key's version. The path is either a JSON Pointer such as
+// /user/name, or a JSONPath made of names and array indexes such as $.user.name,
+// $['user'] or $.tags[0]. The value must be declared as JSON.
+func (kv *KeyValueStore) GetPath(key, path string) ([]byte, uint64, error) {
+	tokens, err := parsePath(path)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	item, ok := kv.GetItem(key)
+	if !ok {
+		return nil, 0, &KeyError{Key: key, Err: ErrKeyNotFound}
+	}
+
+	doc, err := decodeDocument(item.Value, item.ContentType)
+	if err != nil {
+		return nil, 0, &KeyError{Key: key, Err: err}
+	}
+
+	selected, err := lookup(doc, tokens)
+	if err != nil {
+		return nil, 0, &KeyError{Key: key, Err: fmt.Errorf("%w: %s", err, path)}
+	}
+
+	value, err := encodeDocument(selected)
+	if err != nil {
+		return nil, 0, &KeyError{Key: key, Err: err}
+	}
+
+	return value, item.Version, nil
+}
+
+// Patch atomically applies a JSON Patch (RFC 6902) to the JSON value of key and
+// returns the new item. Either every operation of the patch is applied or, if one
+// fails, none is. Only the resulting value is logged, and the key keeps its TTL.
+// A failed test operation returns ErrPatchTestFailed.
+func (kv *KeyValueStore) Patch(key string, patch []byte) (ScanItem, error) {
+	ops, err := parsePatch(patch)
+	if err != nil {
+		return ScanItem{}, &KeyError{Key: key, Err: err}
+	}
+
+	e, err := kv.update(key, func(current *entry, _ time.Time) (*entry, error) {
+		if current == nil {
+			return nil, ErrKeyNotFound
+		}
+
+		doc, decodeErr := decodeDocument(current.value, current.contentType)
+		if decodeErr != nil {
+			return nil, decodeErr
+		}
+
+		for i, op := range ops {
+			var applyErr error
+			if doc, applyErr = op.apply(doc); applyErr != nil {
+				return nil, fmt.Errorf("patch operation %d (%s %s): %w", i, op.Op, op.Path, applyErr)
+			}
+		}
+
+		value, encodeErr := encodeDocument(doc)
+		if encodeErr != nil {
+			return nil, encodeErr
+		}
+
+		return newEntry(value, current.contentType, current.expiresAt, 0), nil
+	})
+	if err != nil {
+		return ScanItem{}, err
+	}
+
+	return e.item(key), nil
+}
+
+// Merge atomically applies a JSON Merge Patch (RFC 7396) to the JSON value of key
+// and returns the new item. A key that doesn't exist is created as if its value
+// were null. Only the resulting value is logged, and the key keeps its TTL.
+func (kv *KeyValueStore) Merge(key string, patch []byte) (ScanItem, error) {
+	mergePatch, err := decodeDocument(patch, ContentTypeJSON)
+	if err != nil {
+		return ScanItem{}, &KeyError{Key: key, Err: invalidField("patch", "is not valid JSON")}
+	}
+
+	e, err := kv.update(key, func(current *entry, _ time.Time) (*entry, error) {
+		var doc any
+		contentType, expiresAt := ContentTypeJSON, time.Time{}
+		if current != nil {
+			var decodeErr error
+			if doc, decodeErr = decodeDocument(current.value, current.contentType); decodeErr != nil {
+				return nil, decodeErr
+			}
+			contentType, expiresAt = current.contentType, current.expiresAt
+		}
+
+		value, encodeErr := encodeDocument(merge(doc, mergePatch))
+		if encodeErr != nil {
+			return nil, encodeErr
+		}
+
+		return newEntry(value, contentType, expiresAt, 0), nil
+	})
+	if err != nil {
+		return ScanItem{}, err
+	}
+
+	return e.item(key), nil
+}
+
+// decodeDocument decodes a value declared as JSON. Numbers are kept as written.
+func decodeDocument(value []byte, contentType string) (any, error) {
+	if !IsJSON(contentType) {
+		return nil, ErrNotJSON
+	}
+
+	d := json.NewDecoder(bytes.NewReader(value))
+	d.UseNumber()
+
+	var doc any
+	if err := d.Decode(&doc); err != nil {
+		return nil, fmt.Errorf("%w: %w", ErrNotJSON, err)
+	}
+	if _, err := d.Token(); !errors.Is(err, io.EOF) {
+		return nil, fmt.Errorf("%w: trailing data", ErrNotJSON)
+	}
+
+	return doc, nil
+}
+
+// encodeDocument encodes a document decoded by decodeDocument. Object members are
+// written in key order.
+func encodeDocument(doc any) ([]byte, error) {
+	var buf bytes.Buffer
+	e := json.NewEncoder(&buf)
+	e.SetEscapeHTML(false)
+	if err := e.Encode(doc); err != nil {
+		return nil, fmt.Errorf("failed to encode JSON: %w", err)
+	}
+
+	return bytes.TrimSuffix(buf.Bytes(), []byte("\n")), nil
+}
+
+// parsePath parses a JSON Pointer or a JSONPath of names and array indexes into
+// the reference tokens of a JSON Pointer.
+func parsePath(path string) ([]string, error) {
+	if path == "" || strings.HasPrefix(path, "/") {
+		return parsePointer("path", path)
+	}
+
+	if !strings.HasPrefix(path, "$") {
+		return nil, invalidField("path", "must be a JSON Pointer starting with / or a JSONPath starting with $")
+	}
+
+	var tokens []string
+	for rest := path[1:]; rest != ""; {
+		switch {
+		case rest[0] == '.':
+			end := strings.IndexAny(rest[1:], ".[")
+			if end < 0 {
+				end = len(rest) - 1
+			}
+			name := rest[1 : end+1]
+			if name == "" || name == "*" {
+				return nil, invalidField("path", "unsupported JSONPath syntax at %q", rest)
+			}
+			tokens, rest = append(tokens, name), rest[end+1:]
+		case strings.HasPrefix(rest, "['") || strings.HasPrefix(rest, `["`):
+			end := strings.Index(rest[2:], string(rest[1])+"]")
+			if end < 0 {
+				return nil, invalidField("path", "unterminated name at %q", rest)
+			}
+			tokens, rest = append(tokens, rest[2:end+2]), rest[end+4:]
+		case rest[0] == '[':
+			end := strings.IndexByte(rest, ']')
+			if end < 0 {
+				return nil, invalidField("path", "unterminated index at %q", rest)
+			}
+			if _, err := strconv.ParseUint(rest[1:end], 10, 0); err != nil {
+				return nil, invalidField("path", "unsupported JSONPath syntax at %q", rest)
+			}
+			tokens, rest = append(tokens, rest[1:end]), rest[end+1:]
+		default:
+			return nil, invalidField("path", "unsupported JSONPath syntax at %q", rest)
+		}
+	}
+
+	return tokens, nil
+}
+
+// parsePointer parses the JSON Pointer in field into its reference tokens.
+func parsePointer(field, pointer string) ([]string, error) {
+	if pointer == "" {
+		return nil, nil
+	}
+	if !strings.HasPrefix(pointer, "/") {
+		return nil, invalidField(field, "JSON Pointer must start with /: %q", pointer)
+	}
+
+	tokens := strings.Split(pointer[1:], "/")
+	for i, token := range tokens {
+		tokens[i] = strings.NewReplacer("~1", "/", "~0", "~").Replace(token)
+	}
+
+	return tokens, nil
+}
+
+// lookup returns the value at tokens in doc.
+func lookup(doc any, tokens []string) (any, error) {
+	for _, token := range tokens {
+		switch node := doc.(type) {
+		case map[string]any:
+			child, ok := node[token]
+			if !ok {
+				return nil, ErrPathNotFound
+			}
+			doc = child
+		case []any:
+			i, err := arrayIndex(token, len(node)-1)
+			if err != nil {
+				return nil, err
+			}
+			doc = node[i]
+		default:
+			return nil, ErrPathNotFound
+		}
+	}
+
+	return doc, nil
+}
+
+// arrayIndex parses token as an index of an array no greater than limit.
+func arrayIndex(token string, limit int) (int, error) {
+	i, err := strconv.Atoi(token)
+	if err != nil || i < 0 || i > limit || (len(token) > 1 && token[0] == '0') {
+		return 0, ErrPathNotFound
+	}
+
+	return i, nil
+}
+
+// modify replaces the container that holds the last token of tokens in doc with
+// the result of fn, and returns the updated document.
+func modify(doc any, tokens []string, fn func(parent any, token string) (any, error)) (any, error) {
+	if len(tokens) == 1 {
+		return fn(doc, tokens[0])
+	}
+
+	child, err := lookup(doc, tokens[:1])
+	if err != nil {
+		return nil, err
+	}
+
+	updated, err := modify(child, tokens[1:], fn)
+	if err != nil {
+		return nil, err
+	}
+
+	switch node := doc.(type) {
+	case map[string]any:
+		node[tokens[0]] = updated
+	case []any:
+		i, _ := arrayIndex(tokens[0], len(node)-1)
+		node[i] = updated
+	}
+
+	return doc, nil
+}
+
+// add adds value at tokens in doc, inserting it into an array or setting an object member.
+func add(doc any, tokens []string, value any) (any, error) {
+	if len(tokens) == 0 {
+		return value, nil
+	}
+
+	return modify(doc, tokens, func(parent any, token string) (any, error) {
+		switch node := parent.(type) {
+		case map[string]any:
+			node[token] = value
+			return node, nil
+		case []any:
+			if token == "-" {
+				return append(node, value), nil
+			}
+			i, err := arrayIndex(token, len(node))
+			if err != nil {
+				return nil, err
+			}
+			return slices.Insert(node, i, value), nil
+		default:
+			return nil, ErrPathNotFound
+		}
+	})
+}
+
+// remove removes the value at tokens from doc and returns the updated document and the removed value.
+func remove(doc any, tokens []string) (any, any, error) {
+	if len(tokens) == 0 {
+		return nil, nil, errors.New("can't remove the whole document")
+	}
+
+	var removed any
+	doc, err := modify(doc, tokens, func(parent any, token string) (any, error) {
+		switch node := parent.(type) {
+		case map[string]any:
+			child, ok := node[token]
+			if !ok {
+				return nil, ErrPathNotFound
+			}
+			removed = child
+			delete(node, token)
+			return node, nil
+		case []any:
+			i, err := arrayIndex(token, len(node)-1)
+			if err != nil {
+				return nil, err
+			}
+			removed = node[i]
+			return slices.Delete(node, i, i+1), nil
+		default:
+			return nil, ErrPathNotFound
+		}
+	})
+
+	return doc, removed, err
+}
+
+// merge applies a JSON Merge Patch to doc, as described in RFC 7396.
+func merge(doc, patch any) any {
+	members, ok := patch.(map[string]any)
+	if !ok {
+		return patch
+	}
+
+	target, ok := doc.(map[string]any)
+	if !ok {
+		target = make(map[string]any, len(members))
+	}
+
+	for name, value := range members {
+		if value == nil {
+			delete(target, name)
+		} else {
+			target[name] = merge(target[name], value)
+		}
+	}
+
+	return target
+}
+
+// equalDocuments reports whether two decoded JSON values are equal, comparing
+// numbers by value.
+func equalDocuments(a, b any) bool {
+	switch a := a.(type) {
+	case map[string]any:
+		b, ok := b.(map[string]any)
+		if !ok || len(a) != len(b) {
+			return false
+		}
+		for name, value := range a {
+			other, found := b[name]
+			if !found || !equalDocuments(value, other) {
+				return false
+			}
+		}
+		return true
+	case []any:
+		b, ok := b.([]any)
+		return ok && slices.EqualFunc(a, b, equalDocuments)
+	case json.Number:
+		b, ok := b.(json.Number)
+		if !ok {
+			return false
+		}
+		x, xErr := a.Float64()
+		y, yErr := b.Float64()
+		return a == b || (xErr == nil && yErr == nil && x == y)
+	default:
+		return a == b
+	}
+}
+
+// patchOp is a single operation of a JSON Patch.
+type patchOp struct {
+	Op    string          `json:"op"`
+	Path  string          `json:"path"`
+	From  string          `json:"from"`
+	Value json.RawMessage `json:"value"`
+
+	path, from []string
+	value      any
+}
+
+// parsePatch parses a JSON Patch document.
+func parsePatch(patch []byte) ([]patchOp, error) {
+	d := json.NewDecoder(bytes.NewReader(patch))
+	d.UseNumber()
+
+	var ops []patchOp
+	if err := d.Decode(&ops); err != nil {
+		return nil, invalidField("patch", "must be a JSON array of operations: %v", err)
+	}
+
+	for i := range ops {
+		op := &ops[i]
+		field := fmt.Sprintf("patch[%d]", i)
+
+		var err error
+		if op.path, err = parsePointer(field+".path", op.Path); err != nil {
+			return nil, err
+		}
+
+		switch op.Op {
+		case "add", "replace", "test":
+			if op.Value == nil {
+				return nil, invalidField(field+".value", "is required by %s", op.Op)
+			}
+			if op.value, err = decodeDocument(op.Value, ContentTypeJSON); err != nil {
+				return nil, invalidField(field+".value", "%v", err)
+			}
+		case "move", "copy":
+			if op.from, err = parsePointer(field+".from", op.From); err != nil {
+				return nil, err
+			}
+			if op.Op == "move" && len(op.from) < len(op.path) && slices.Equal(op.from, op.path[:len(op.from)]) {
+				return nil, invalidField(field+".path", "can't move a value into itself")
+			}
+		case "remove":
+			if len(op.path) == 0 {
+				return nil, invalidField(field+".path", "can't remove the whole document")
+			}
+		default:
+			return nil, invalidField(field+".op", "unknown operation %q", op.Op)
+		}
+	}
+
+	return ops, nil
+}
+
+// apply applies the operation to doc and returns the updated document.
+func (op *patchOp) apply(doc any) (any, error) {
+	switch op.Op {
+	case "add":
+		return add(doc, op.path, copyDocument(op.value))
+	case "remove":
+		doc, _, err := remove(doc, op.path)
+		return doc, err
+	case "replace":
+		if _, err := lookup(doc, op.path); err != nil {
+			return nil, err
+		}
+		if len(op.path) > 0 {
+			doc, _, _ = remove(doc, op.path)
+		}
+		return add(doc, op.path, copyDocument(op.value))
+	case "move":
+		if slices.Equal(op.from, op.path) {
+			_, err := lookup(doc, op.from)
+			return doc, err
+		}
+		doc, value, err := remove(doc, op.from)
+		if err != nil {
+			return nil, err
+		}
+		return add(doc, op.path, value)
+	case "copy":
+		value, err := lookup(doc, op.from)
+		if err != nil {
+			return nil, err
+		}
+		return add(doc, op.path, copyDocument(value))
+	case "test":
+		value, err := lookup(doc, op.path)
+		if err != nil || !equalDocuments(value, op.value) {
+			return nil, ErrPatchTestFailed
+		}
+		return doc, nil
+	default:
+		return nil, fmt.Errorf("unknown operation %q", op.Op)
+	}
+}
+
+// copyDocument returns a deep copy of a decoded JSON value, so that a value added
+// to a document more than once isn't shared.
+func copyDocument(doc any) any {
+	switch node := doc.(type) {
+	case map[string]any:
+		copied := make(map[string]any, len(node))
+		for name, value := range node {
+			copied[name] = copyDocument(value)
+		}
+		return copied
+	case []any:
+		copied := make([]any, len(node))
+		for i, value := range node {
+			copied[i] = copyDocument(value)
+		}
+		return copied
+	default:
+		return doc
+	}
+}
@@ -0,0 +1,25 @@
+package keyvaluestore
+
+import "fmt"
+
+// DurabilityMode selects when transaction log records are flushed to stable storage.
+type DurabilityMode string
+
+const (
+	// DurabilityAlways fsyncs the log before a write returns, so acknowledged writes survive a crash.
+	DurabilityAlways DurabilityMode = "always"
+	// DurabilityEverySec fsyncs the log once a second, so a crash loses at most the last second of writes.
+	DurabilityEverySec DurabilityMode = "everysec"
+	// DurabilityNo writes records without fsyncing and leaves flushing to the operating system.
+	DurabilityNo DurabilityMode = "no"
+)
+
+// ParseDurabilityMode converts a mode name into a DurabilityMode.
+func ParseDurabilityMode(name string) (DurabilityMode, error) {
+	switch mode := DurabilityMode(name); mode {
+	case DurabilityAlways, DurabilityEverySec, DurabilityNo:
+		return mode, nil
+	default:
+		return "", fmt.Errorf("unknown durability mode: %s", name)
+	}
+}
@@ -0,0 +1,238 @@
+package keyvaluestore
+
+import (
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/rand"
+	"encoding/base64"
+	"fmt"
+	"log"
+	"os"
+	"strings"
+)
+
+// maxKeyIDLength is the longest key ID, which is stored in file headers with a one-byte length.
+const maxKeyIDLength = 255
+
+// EncryptionOptions selects where the keys that encrypt the transaction log and
+// snapshots at rest are loaded from. The key file takes precedence over the
+// environment variable. Encryption is disabled if neither is set.
+type EncryptionOptions struct {
+	// KeyFile is the path of a file holding the keys.
+	KeyFile string
+	// KeyEnv is the name of an environment variable holding the keys.
+	KeyEnv string
+}
+
+// Enabled reports whether a key source is configured.
+func (opts EncryptionOptions) Enabled() bool {
+	return opts.KeyFile != "" || opts.KeyEnv != ""
+}
+
+// LoadKeyring loads the keys from the configured source. It returns a nil Keyring
+// if encryption is disabled.
+func (opts EncryptionOptions) LoadKeyring() (*Keyring, error) {
+	switch {
+	case opts.KeyFile != "":
+		data, err := os.ReadFile(opts.KeyFile)
+		if err != nil {
+			return nil, fmt.Errorf("failed to read key file: %w", err)
+		}
+		return ParseKeyring(string(data))
+	case opts.KeyEnv != "":
+		data, ok := os.LookupEnv(opts.KeyEnv)
+		if !ok {
+			return nil, fmt.Errorf("environment variable %s is not set", opts.KeyEnv)
+		}
+		return ParseKeyring(data)
+	default:
+		return nil, nil
+	}
+}
+
+// Keyring holds the AES-GCM keys that encrypt the transaction log and snapshots.
+// New files are encrypted with the active key and record its ID in their header.
+// The other keys are retired: they are only used to read files written before
+// the active key was rotated in.
+type Keyring struct {
+	active string
+	keys   map[string]cipher.AEAD
+}
+
+// ParseKeyring parses keys given as "<id>:<base64 key>", separated by newlines or
+// commas. The first key is the active one. Keys must be 16, 24 or 32 bytes long,
+// selecting AES-128, AES-192 or AES-256. Blank lines and lines starting with # are
+// ignored.
+func ParseKeyring(data string) (*Keyring, error) {
+	k := &Keyring{keys: make(map[string]cipher.AEAD)}
+	for _, line := range strings.FieldsFunc(data, func(r rune) bool { return r == '\n' || r == ',' }) {
+		line = strings.TrimSpace(line)
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+
+		id, encoded, ok := strings.Cut(line, ":")
+		id = strings.TrimSpace(id)
+		if !ok || id == "" || len(id) > maxKeyIDLength {
+			return nil, fmt.Errorf("invalid key %q: expected <id>:<base64 key> with an ID of 1 to %d bytes", id, maxKeyIDLength)
+		}
+		if _, dup := k.keys[id]; dup {
+			return nil, fmt.Errorf("duplicate key ID %q", id)
+		}
+
+		key, err := base64.StdEncoding.DecodeString(strings.TrimSpace(encoded))
+		if err != nil {
+			return nil, fmt.Errorf("invalid key %q: %w", id, err)
+		}
+
+		block, err := aes.NewCipher(key)
+		if err != nil {
+			return nil, fmt.Errorf("invalid key %q: %w", id, err)
+		}
+		aead, err := cipher.NewGCM(block)
+		if err != nil {
+			return nil, fmt.Errorf("invalid key %q: %w", id, err)
+		}
+
+		k.keys[id] = aead
+		if k.active == "" {
+			k.active = id
+		}
+	}
+
+	if k.active == "" {
+		return nil, fmt.Errorf("no encryption keys found")
+	}
+
+	return k, nil
+}
+
+// ActiveKeyID returns the ID of the key new files are encrypted with, or an empty
+// string for a nil Keyring.
+func (k *Keyring) ActiveKeyID() string {
+	if k == nil {
+		return ""
+	}
+
+	return k.active
+}
+
+// Has reports whether the keyring holds the key with the given ID.
+func (k *Keyring) Has(id string) bool {
+	if k == nil {
+		return false
+	}
+
+	_, ok := k.keys[id]
+	return ok
+}
+
+// aead returns the cipher for the key with the given ID.
+func (k *Keyring) aead(id string) (cipher.AEAD, error) {
+	if k != nil {
+		if aead, ok := k.keys[id]; ok {
+			return aead, nil
+		}
+	}
+
+	return nil, fmt.Errorf("%w: %q", ErrUnknownKey, id)
+}
+
+// seal encrypts plaintext with aead under a random nonce, which is prepended to the result.
+func seal(aead cipher.AEAD, plaintext, additionalData []byte) ([]byte, error) {
+	nonce := make([]byte, aead.NonceSize(), aead.NonceSize()+len(plaintext)+aead.Overhead())
+	if _, err := rand.Read(nonce); err != nil {
+		return nil, fmt.Errorf("failed to generate nonce: %w", err)
+	}
+
+	return aead.Seal(nonce, nonce, plaintext, additionalData), nil
+}
+
+// unseal decrypts data sealed by seal.
+func unseal(aead cipher.AEAD, data, additionalData []byte) ([]byte, error) {
+	if len(data) < aead.NonceSize()+aead.Overhead() {
+		return nil, fmt.Errorf("ciphertext too short")
+	}
+
+	return aead.Open(nil, data[:aead.NonceSize()], data[aead.NonceSize():], additionalData)
+}
+
+// RotateKeys replaces the keyring that encrypts the transaction log and snapshots.
+// New log segments and snapshots are encrypted with the active key of keys, and the
+// log moves on to a new segment with its next write. Files written before keep
+// their key, so keys must still hold every key that a log segment or snapshot on
+// disk is encrypted with; otherwise RotateKeys fails with ErrUnknownKey and the
+// keyring is left unchanged. A retired key can be dropped once the snapshots and
+// log segments that use it have been removed.
+func (kv *KeyValueStore) RotateKeys(keys *Keyring) error {
+	if kv.logger == nil {
+		return ErrLoggingDisabled
+	}
+	if keys == nil {
+		return fmt.Errorf("%w: no encryption keys given", ErrInvalidArgument)
+	}
+
+	// No snapshot is written while the keys in use are checked
+	kv.snapshotMu.Lock()
+	defer kv.snapshotMu.Unlock()
+
+	snapshots, err := kv.listSnapshots()
+	if err != nil {
+		return err
+	}
+	for _, info := range snapshots {
+		if info.KeyID != "" && !keys.Has(info.KeyID) {
+			return fmt.Errorf("%w: %q is needed by snapshot %s", ErrUnknownKey, info.KeyID, info.Name)
+		}
+	}
+
+	if err = kv.logger.SetKeys(keys); err != nil {
+		return err
+	}
+	log.Printf("Rotated encryption keys, the active key is now %q", keys.ActiveKeyID())
+
+	return nil
+}
+
+// keyring returns the keys the store is encrypted with, or nil if it isn't encrypted.
+func (kv *KeyValueStore) keyring() *Keyring {
+	if kv.logger == nil {
+		return nil
+	}
+
+	return kv.logger.keyring()
+}
+
+// SetKeys replaces the keyring of the log, once it has checked that keys holds the
+// key of every segment on disk. The next write moves on to a new segment if the
+// active key has changed.
+func (l *Logger) SetKeys(keys *Keyring) error {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	segments, err := l.listSegments()
+	if err != nil {
+		return err
+	}
+
+	for _, n := range segments {
+		keyID, _, headerErr := readSegmentHeader(l.segmentPath(n))
+		if headerErr != nil {
+			return headerErr
+		}
+		if keyID != "" && !keys.Has(keyID) {
+			return fmt.Errorf("%w: %q is needed by log segment %d", ErrUnknownKey, keyID, n)
+		}
+	}
+
+	l.keys = keys
+	return nil
+}
+
+// keyring returns the keys the log is encrypted with.
+func (l *Logger) keyring() *Keyring {
+	l.mu.RLock()
+	defer l.mu.RUnlock()
+
+	return l.keys
+}
@@ -0,0 +1,90 @@
+package keyvaluestore
+
+import (
+	"errors"
+	"fmt"
+)
+
+var (
+	// ErrKeyNotFound is returned when an operation requires a key that does not exist.
+	ErrKeyNotFound = errors.New("key not found")
+	// ErrKeyExists is returned when a write that requires an absent key finds it present.
+	ErrKeyExists = errors.New("key already exists")
+	// ErrVersionMismatch is returned when a conditional write expects a different key version.
+	ErrVersionMismatch = errors.New("version mismatch")
+	// ErrInvalidArgument is returned when a request is malformed.
+	ErrInvalidArgument = errors.New("invalid argument")
+	// ErrInvalidScan is returned when a scan has conflicting bounds or a malformed continuation token.
+	ErrInvalidScan = fmt.Errorf("%w: invalid scan", ErrInvalidArgument)
+	// ErrNotJSON is returned when a JSON document operation is run on a value that isn't declared as JSON.
+	ErrNotJSON = errors.New("value is not declared as JSON")
+	// ErrPathNotFound is returned when a path doesn't select anything in a JSON document.
+	ErrPathNotFound = errors.New("path not found")
+	// ErrPatchTestFailed is returned when a test operation of a JSON Patch doesn't hold.
+	ErrPatchTestFailed = errors.New("patch test failed")
+	// ErrWrongType is returned when an operation on a data structure finds a value of another type.
+	ErrWrongType = errors.New("wrong type")
+	// ErrNotNumber is returned when a counter is incremented but the value of the key isn't a number.
+	ErrNotNumber = errors.New("value is not a number")
+	// ErrOverflow is returned when incrementing a counter would overflow it.
+	ErrOverflow = errors.New("counter overflow")
+	// ErrIndexNotFound is returned when a query names a secondary index that does not exist.
+	ErrIndexNotFound = errors.New("index not found")
+	// ErrIndexExists is returned when a secondary index is created with the name of an existing one.
+	ErrIndexExists = errors.New("index already exists")
+	// ErrOutOfMemory is returned when a write would exceed the memory limit and no key can be evicted.
+	ErrOutOfMemory = errors.New("memory limit reached")
+	// ErrCompacted is returned when a watch starts from a revision that is no longer in the event history.
+	ErrCompacted = errors.New("revision has been compacted")
+	// ErrWatcherTooSlow is reported by a watcher that was cancelled because its buffer filled up.
+	ErrWatcherTooSlow = errors.New("watcher fell too far behind")
+	// ErrCorruptLog is returned when a transaction log record fails its checksum before the end of the log.
+	ErrCorruptLog = errors.New("transaction log is corrupt")
+	// ErrCorruptSnapshot is returned when a snapshot file fails its checksum or can't be decoded.
+	ErrCorruptSnapshot = errors.New("snapshot is corrupt")
+	// ErrSnapshotNotFound is returned when an operation names a snapshot that does not exist.
+	ErrSnapshotNotFound = errors.New("snapshot not found")
+	// ErrSnapshotRequired is returned when deleting a snapshot would leave the store unrecoverable.
+	ErrSnapshotRequired = errors.New("snapshot is required for recovery")
+	// ErrLoggingDisabled is returned by operations on snapshots when the transaction log is disabled.
+	ErrLoggingDisabled = errors.New("logging is disabled")
+	// ErrEncryptionDisabled is returned when the keys are rotated but encryption at rest is disabled.
+	ErrEncryptionDisabled = errors.New("encryption is disabled")
+	// ErrUnknownKey is returned when a file is encrypted with a key that isn't in the keyring.
+	ErrUnknownKey = errors.New("encryption key is not available")
+	// ErrLogBackpressure is returned when a write is refused because the transaction log is falling behind.
+	ErrLogBackpressure = errors.New("transaction log is falling behind")
+)
+
+// KeyError records the key an operation failed on.
+type KeyError struct {
+	Key string
+	Err error
+}
+
+func (e *KeyError) Error() string {
+	return fmt.Sprintf("key %s: %v", e.Key, e.Err)
+}
+
+func (e *KeyError) Unwrap() error {
+	return e.Err
+}
+
+// FieldError records the request field that made an operation fail.
+type FieldError struct {
+	Field string
+	Err   error
+}
+
+func (e *FieldError) Error() string {
+	return fmt.Sprintf("%s: %v", e.Field, e.Err)
+}
+
+func (e *FieldError) Unwrap() error {
+	return e.Err
+}
+
+// invalidField returns an ErrInvalidArgument for field with a formatted description.
+func invalidField(field string, format string, args ...any) error {
+	return &FieldError{Field: field, Err: fmt.Errorf("%w: "+format, append([]any{ErrInvalidArgument}, args...)...)}
+}
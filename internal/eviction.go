@@ -0,0 +1,170 @@
+package keyvaluestore
+
+import (
+	"fmt"
+	"log"
+	"time"
+)
+
+// EvictionPolicy selects which keys are removed when the store reaches its memory limit.
+type EvictionPolicy string
+
+const (
+	// NoEviction rejects writes that would exceed the memory limit.
+	NoEviction EvictionPolicy = "noeviction"
+	// AllKeysLRU evicts the least recently used key.
+	AllKeysLRU EvictionPolicy = "allkeys-lru"
+	// AllKeysLFU evicts the least frequently used key.
+	AllKeysLFU EvictionPolicy = "allkeys-lfu"
+	// AllKeysRandom evicts a random key.
+	AllKeysRandom EvictionPolicy = "allkeys-random"
+	// VolatileTTL evicts the key with a TTL that is closest to expiring.
+	VolatileTTL EvictionPolicy = "volatile-ttl"
+)
+
+const (
+	// evictionSamples is how many keys are sampled when picking a key to evict.
+	// Like Redis, eviction is approximate rather than scanning the whole keyspace.
+	evictionSamples = 5
+
+	// entryOverhead is a rough estimate of the bookkeeping cost of a single key.
+	entryOverhead = 64
+)
+
+// ParseEvictionPolicy converts a policy name into an EvictionPolicy.
+func ParseEvictionPolicy(name string) (EvictionPolicy, error) {
+	switch policy := EvictionPolicy(name); policy {
+	case NoEviction, AllKeysLRU, AllKeysLFU, AllKeysRandom, VolatileTTL:
+		return policy, nil
+	default:
+		return "", fmt.Errorf("unknown eviction policy: %s", name)
+	}
+}
+
+// SetMemoryLimit bounds the memory used by keys and values to maxMemory bytes,
+// evicting keys according to policy once the limit is reached.
+// A maxMemory of zero or less removes the limit.
+func (kv *KeyValueStore) SetMemoryLimit(maxMemory int64, policy EvictionPolicy) {
+	kv.mu.Lock()
+	defer kv.mu.Unlock()
+
+	kv.maxMemory = maxMemory
+	kv.evictionPolicy = policy
+}
+
+// UsedMemory returns the estimated number of bytes used by keys and values.
+func (kv *KeyValueStore) UsedMemory() int64 {
+	kv.mu.RLock()
+	defer kv.mu.RUnlock()
+
+	return kv.usedMemory
+}
+
+// entrySize estimates how many bytes key and e occupy in the store.
+func entrySize(key string, e *entry) int64 {
+	return int64(len(key) + len(e.value) + len(e.contentType) + entryOverhead)
+}
+
+// makeRoom evicts keys until a write that grows the store by needed() bytes fits
+// within the memory limit. needed is re-evaluated after every eviction because
+// evicting a key the write replaces changes how much it grows the store.
+// The caller must hold kv.mu for writing.
+func (kv *KeyValueStore) makeRoom(needed func() int64) error {
+	if kv.maxMemory <= 0 {
+		return nil
+	}
+
+	// A write larger than the whole limit can't fit however many keys are evicted,
+	// so it is refused before any of them are
+	if needed() > kv.maxMemory {
+		return ErrOutOfMemory
+	}
+
+	for kv.usedMemory+needed() > kv.maxMemory {
+		victim, ok := kv.evictionCandidate()
+		if !ok {
+			return ErrOutOfMemory
+		}
+
+		if _, err := kv.recordMutation(LogEntry{Timestamp: time.Now(), Operation: "EVICT", Key: victim}); err != nil {
+			return err
+		}
+		kv.remove(victim)
+		log.Printf("Evicted \"%s\" from kvs (%s)", victim, kv.evictionPolicy)
+	}
+
+	return nil
+}
+
+// sizeDelta returns how many bytes storing e under key would add to the store.
+// A nil e measures removing the key. The caller must hold kv.mu.
+func (kv *KeyValueStore) sizeDelta(key string, e *entry) int64 {
+	var delta int64
+	if e != nil {
+		delta += entrySize(key, e)
+	}
+	if old, ok := kv.data[key]; ok {
+		delta -= entrySize(key, old)
+	}
+
+	return delta
+}
+
+// evictionCandidate samples keys and picks the best one to evict under the current policy.
+// The caller must hold kv.mu.
+func (kv *KeyValueStore) evictionCandidate() (string, bool) {
+	switch kv.evictionPolicy {
+	case AllKeysLRU:
+		return sampleKeys(kv.data, kv.data, func(a, b *entry) bool {
+			return a.lastAccess.Load() < b.lastAccess.Load()
+		})
+	case AllKeysLFU:
+		return sampleKeys(kv.data, kv.data, func(a, b *entry) bool {
+			if a.hits.Load() != b.hits.Load() {
+				return a.hits.Load() < b.hits.Load()
+			}
+			return a.lastAccess.Load() < b.lastAccess.Load()
+		})
+	case AllKeysRandom:
+		return sampleKeys(kv.data, kv.data, func(_, _ *entry) bool {
+			return false
+		})
+	case VolatileTTL:
+		return sampleKeys(kv.data, kv.volatile, func(a, b *entry) bool {
+			return a.expiresAt.Before(b.expiresAt)
+		})
+	case NoEviction:
+		return "", false
+	default:
+		return "", false
+	}
+}
+
+// sampleKeys looks at up to evictionSamples keys from candidates and returns
+// the one whose entry in data sorts first according to less.
+func sampleKeys[V any](data map[string]*entry, candidates map[string]V, less func(a, b *entry) bool) (string, bool) {
+	var best string
+	var bestEntry *entry
+	sampled := 0
+
+	// map iteration order is randomized, which gives us a cheap random sample
+	for key := range candidates {
+		e := data[key]
+		if bestEntry == nil || less(e, bestEntry) {
+			best, bestEntry = key, e
+		}
+
+		sampled++
+		if sampled == evictionSamples {
+			break
+		}
+	}
+
+	return best, bestEntry != nil
+}
+
+// touch records an access to e for the LRU and LFU eviction policies.
+func (e *entry) touch(now time.Time) {
+	e.lastAccess.Store(now.UnixNano())
+	e.hits.Add(1)
+}
@@ -0,0 +1,66 @@
+package keyvaluestore
+
+import (
+	"log"
+	"time"
+)
+
+// StartExpiryReaper starts a background goroutine that removes expired keys
+// from the store every interval. Expired keys are also removed lazily when
+// they are read, so the reaper only bounds how long they can occupy memory.
+func (kv *KeyValueStore) StartExpiryReaper(interval time.Duration) {
+	go kv.expiryReaper(interval)
+}
+
+// expiryReaper periodically removes every expired key from the store.
+func (kv *KeyValueStore) expiryReaper(interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for range ticker.C {
+		if n := kv.ReapExpired(); n > 0 {
+			log.Printf("Expired %d keys from kvs", n)
+		}
+	}
+}
+
+// ReapExpired removes all keys whose TTL has elapsed and returns how many were removed.
+func (kv *KeyValueStore) ReapExpired() int {
+	kv.mu.Lock()
+	defer kv.mu.Unlock()
+
+	now := time.Now()
+	removed := 0
+	for key := range kv.volatile {
+		if kv.expireLocked(key, now) {
+			removed++
+		}
+	}
+
+	return removed
+}
+
+// expire removes key from the store if its TTL has elapsed.
+func (kv *KeyValueStore) expire(key string) {
+	kv.mu.Lock()
+	defer kv.mu.Unlock()
+
+	kv.expireLocked(key, time.Now())
+}
+
+// expireLocked removes key and records the expiration in the transaction log
+// if the key's TTL has elapsed at now. The caller must hold kv.mu for writing.
+func (kv *KeyValueStore) expireLocked(key string, now time.Time) bool {
+	e, ok := kv.data[key]
+	if !ok || !e.expired(now) {
+		return false
+	}
+
+	// The key is removed even if the log can't be written, as it is expired either way
+	if _, err := kv.recordMutation(LogEntry{Timestamp: now, Operation: "EXPIRE", Key: key}); err != nil {
+		log.Printf("Failed to log expiration of \"%s\": %v", key, err)
+	}
+	kv.remove(key)
+
+	return true
+}
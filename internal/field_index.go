@@ -0,0 +1,382 @@
+package keyvaluestore
+
+import (
+	"cmp"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"log"
+	"math"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/google/btree"
+)
+
+// IndexDefinition declares a secondary index on a field of the values declared as JSON.
+type IndexDefinition struct {
+	Name string
+	// Path selects the indexed field, as a JSON Pointer such as /user_id or a JSONPath such as $.user_id.
+	Path string
+}
+
+// ParseIndexDefinition parses an index definition written as name=path, such as user_id=$.user_id.
+func ParseIndexDefinition(s string) (IndexDefinition, error) {
+	name, path, ok := strings.Cut(s, "=")
+	if !ok {
+		return IndexDefinition{}, fmt.Errorf("index must be written as name=path: %q", s)
+	}
+
+	return IndexDefinition{Name: name, Path: path}, nil
+}
+
+// IndexQuery selects the keys returned by QueryIndex.
+type IndexQuery struct {
+	// Index is the name of the index to query.
+	Index string
+	// Equal selects the keys whose field equals this JSON value. It can't be combined with Start or End.
+	Equal []byte
+	// Start is the inclusive lower bound of a range, as a JSON value.
+	Start []byte
+	// End is the exclusive upper bound of a range, as a JSON value. Empty means no upper bound.
+	End []byte
+	// Limit is the maximum number of keys returned, defaulting to 1000 and capped at 10000.
+	Limit int
+	// ContinuationToken resumes a previous query after the last key it returned.
+	ContinuationToken string
+}
+
+// CreateIndex adds a secondary index on the field at def.Path of every value declared
+// as JSON. Values without the field, or where it holds an object or an array, are
+// left out of the index. The index is built from the current contents of the store
+// and kept up to date by every later change, including the snapshot and transaction
+// log replayed by InitLogging, so indexes are best created before it.
+func (kv *KeyValueStore) CreateIndex(def IndexDefinition) error {
+	if def.Name == "" {
+		return invalidField("name", "must not be empty")
+	}
+
+	tokens, err := parsePath(def.Path)
+	if err != nil {
+		return err
+	}
+
+	kv.mu.Lock()
+	defer kv.mu.Unlock()
+
+	if _, ok := kv.fieldIndexes[def.Name]; ok {
+		return fmt.Errorf("%w: %s", ErrIndexExists, def.Name)
+	}
+
+	ix := &fieldIndex{path: def.Path, tokens: tokens}
+	ix.clear()
+	for key, e := range kv.data {
+		ix.put(key, e)
+	}
+	kv.fieldIndexes[def.Name] = ix
+	log.Printf("Created index %s on %s with %d keys", def.Name, def.Path, ix.tree.Len())
+
+	return nil
+}
+
+// DropIndex removes the secondary index called name.
+func (kv *KeyValueStore) DropIndex(name string) error {
+	kv.mu.Lock()
+	defer kv.mu.Unlock()
+
+	if _, ok := kv.fieldIndexes[name]; !ok {
+		return fmt.Errorf("%w: %s", ErrIndexNotFound, name)
+	}
+
+	delete(kv.fieldIndexes, name)
+	log.Printf("Dropped index %s", name)
+
+	return nil
+}
+
+// QueryIndex returns the keys whose indexed field is selected by query, one page at a
+// time, ordered by the value of the field and then by key. Values are ordered by type
+// first, null, false, true, numbers and then strings, and a range only selects values
+// of the type of its bounds. Without an equality or a range every indexed key is returned.
+func (kv *KeyValueStore) QueryIndex(query IndexQuery) (ScanResult, error) {
+	bounds, err := query.bounds()
+	if err != nil {
+		return ScanResult{}, err
+	}
+
+	limit := query.Limit
+	if limit <= 0 {
+		limit = defaultScanLimit
+	}
+	limit = min(limit, maxScanLimit)
+
+	kv.mu.RLock()
+	defer kv.mu.RUnlock()
+
+	ix, ok := kv.fieldIndexes[query.Index]
+	if !ok {
+		return ScanResult{}, &FieldError{Field: "index", Err: fmt.Errorf("%w: %s", ErrIndexNotFound, query.Index)}
+	}
+
+	now := time.Now()
+	var result ScanResult
+	var last fieldEntry
+	ix.tree.AscendGreaterOrEqual(bounds.start, func(item fieldEntry) bool {
+		if !bounds.includes(item.value) {
+			return false
+		}
+		if item.e.expired(now) {
+			return true
+		}
+
+		if len(result.Items) == limit {
+			// There is at least one more key, so the query can be resumed after the last one returned
+			result.ContinuationToken = last.token()
+			return false
+		}
+
+		result.Items = append(result.Items, item.e.item(item.key))
+		last = item
+		return true
+	})
+
+	return result, nil
+}
+
+// indexBounds is the part of a field index visited by a query.
+type indexBounds struct {
+	start fieldEntry // the first position visited
+	// includes reports whether a value is still within the query, which ends at the first value that isn't.
+	includes func(v fieldValue) bool
+}
+
+// bounds returns the part of the index selected by the query.
+func (query IndexQuery) bounds() (indexBounds, error) {
+	bounds := indexBounds{includes: func(fieldValue) bool { return true }}
+
+	switch {
+	case query.Equal != nil:
+		if query.Start != nil || query.End != nil {
+			return indexBounds{}, invalidField("equal", "can't be combined with a start or end value")
+		}
+		equal, err := parseFieldValue("equal", query.Equal)
+		if err != nil {
+			return indexBounds{}, err
+		}
+		bounds.start = fieldEntry{value: equal}
+		bounds.includes = func(v fieldValue) bool { return v.compare(equal) == 0 }
+	case query.Start != nil || query.End != nil:
+		var start, end fieldValue
+		var err error
+		if query.Start != nil {
+			if start, err = parseFieldValue("start", query.Start); err != nil {
+				return indexBounds{}, err
+			}
+		}
+		if query.End != nil {
+			if end, err = parseFieldValue("end", query.End); err != nil {
+				return indexBounds{}, err
+			}
+		}
+
+		switch {
+		case query.Start == nil:
+			start = fieldValue{kind: end.kind, number: math.Inf(-1)} // the first value of the type
+		case query.End != nil && start.kind != end.kind:
+			return indexBounds{}, invalidField("end", "must be of the same JSON type as start")
+		case query.End != nil && start.compare(end) > 0:
+			return indexBounds{}, invalidField("start", "is after the end value")
+		}
+
+		bounds.start = fieldEntry{value: start}
+		bounds.includes = func(v fieldValue) bool {
+			return v.kind == start.kind && (query.End == nil || v.compare(end) < 0)
+		}
+	}
+
+	if query.ContinuationToken != "" {
+		resume, err := parseFieldToken(query.ContinuationToken)
+		if err != nil || resume.less(bounds.start) {
+			return indexBounds{}, invalidField("continuation_token", "malformed continuation token")
+		}
+		bounds.start = resume
+	}
+
+	return bounds, nil
+}
+
+// fieldIndex is a secondary index on a field of the values declared as JSON.
+type fieldIndex struct {
+	path   string
+	tokens []string
+	tree   *btree.BTreeG[fieldEntry]
+	values map[string]fieldValue // the indexed value of every key in tree
+}
+
+// fieldEntry is an entry of a field index.
+type fieldEntry struct {
+	value fieldValue
+	key   string
+	e     *entry
+}
+
+// less orders field index entries by value and then by key.
+func (a fieldEntry) less(b fieldEntry) bool {
+	if c := a.value.compare(b.value); c != 0 {
+		return c < 0
+	}
+
+	return a.key < b.key
+}
+
+// token encodes the position immediately after the entry.
+func (a fieldEntry) token() string {
+	position, _ := json.Marshal([]any{a.value.json(), a.key + "\x00"})
+	return base64.RawURLEncoding.EncodeToString(position)
+}
+
+// parseFieldToken decodes a position encoded by fieldEntry.token.
+func parseFieldToken(token string) (fieldEntry, error) {
+	data, err := base64.RawURLEncoding.DecodeString(token)
+	if err != nil {
+		return fieldEntry{}, err
+	}
+
+	doc, err := decodeDocument(data, ContentTypeJSON)
+	if err != nil {
+		return fieldEntry{}, err
+	}
+
+	position, ok := doc.([]any)
+	if !ok || len(position) != 2 {
+		return fieldEntry{}, ErrInvalidArgument
+	}
+	value, ok := toFieldValue(position[0])
+	key, isString := position[1].(string)
+	if !ok || !isString {
+		return fieldEntry{}, ErrInvalidArgument
+	}
+
+	return fieldEntry{value: value, key: key}, nil
+}
+
+// clear removes every key from the index.
+func (ix *fieldIndex) clear() {
+	ix.tree = btree.NewG(indexDegree, fieldEntry.less)
+	ix.values = make(map[string]fieldValue)
+}
+
+// put indexes the field of e stored under key, replacing the previous value of key.
+func (ix *fieldIndex) put(key string, e *entry) {
+	ix.remove(key)
+
+	doc, err := decodeDocument(e.value, e.contentType)
+	if err != nil {
+		return
+	}
+	field, err := lookup(doc, ix.tokens)
+	if err != nil {
+		return
+	}
+	value, ok := toFieldValue(field)
+	if !ok {
+		return
+	}
+
+	ix.tree.ReplaceOrInsert(fieldEntry{value: value, key: key, e: e})
+	ix.values[key] = value
+}
+
+// remove removes key from the index.
+func (ix *fieldIndex) remove(key string) {
+	if value, ok := ix.values[key]; ok {
+		ix.tree.Delete(fieldEntry{value: value, key: key})
+		delete(ix.values, key)
+	}
+}
+
+// fieldKind is the type of an indexed value. Values are ordered by type first.
+type fieldKind uint8
+
+const (
+	kindNull fieldKind = iota
+	kindFalse
+	kindTrue
+	kindNumber
+	kindString
+)
+
+// fieldValue is a JSON scalar held by a field index. Numbers are compared as float64.
+type fieldValue struct {
+	kind   fieldKind
+	number float64
+	str    string
+}
+
+// compare orders v and w by type and then by value.
+func (v fieldValue) compare(w fieldValue) int {
+	if c := cmp.Compare(v.kind, w.kind); c != 0 {
+		return c
+	}
+	if c := cmp.Compare(v.number, w.number); c != 0 {
+		return c
+	}
+
+	return strings.Compare(v.str, w.str)
+}
+
+// json returns v as a document that encodeDocument can write.
+func (v fieldValue) json() any {
+	switch v.kind {
+	case kindFalse:
+		return false
+	case kindTrue:
+		return true
+	case kindNumber:
+		return json.Number(strconv.FormatFloat(v.number, 'g', -1, 64))
+	case kindString:
+		return v.str
+	default:
+		return nil
+	}
+}
+
+// toFieldValue converts a document decoded by decodeDocument into an indexed value,
+// reporting false for objects and arrays, which aren't indexed.
+func toFieldValue(doc any) (fieldValue, bool) {
+	switch doc := doc.(type) {
+	case nil:
+		return fieldValue{kind: kindNull}, true
+	case bool:
+		if doc {
+			return fieldValue{kind: kindTrue}, true
+		}
+		return fieldValue{kind: kindFalse}, true
+	case json.Number:
+		number, err := doc.Float64()
+		if err != nil {
+			return fieldValue{}, false
+		}
+		return fieldValue{kind: kindNumber, number: number}, true
+	case string:
+		return fieldValue{kind: kindString, str: doc}, true
+	default:
+		return fieldValue{}, false
+	}
+}
+
+// parseFieldValue parses the JSON scalar in field.
+func parseFieldValue(field string, data []byte) (fieldValue, error) {
+	doc, err := decodeDocument(data, ContentTypeJSON)
+	if err != nil {
+		return fieldValue{}, invalidField(field, "is not valid JSON")
+	}
+
+	value, ok := toFieldValue(doc)
+	if !ok {
+		return fieldValue{}, invalidField(field, "must be a JSON string, number, boolean or null")
+	}
+
+	return value, nil
+}
@@ -0,0 +1,209 @@
+package keyvaluestore
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+
+	"github.com/defoeam/herd/api/proto"
+	"google.golang.org/grpc"
+)
+
+// backupChunkSize is the most backup data sent per message by the Backup RPC.
+const backupChunkSize = 64 << 10
+
+// AdminServer serves the administrative RPCs for a key-value store.
+type AdminServer struct {
+	proto.UnimplementedAdminServiceServer
+	kv         *KeyValueStore
+	encryption EncryptionOptions // where RotateKeys reloads the keys from
+}
+
+// NewAdminServer creates a gRPC server for the administrative RPCs of kv, which
+// reloads the encryption keys of kv from encryption when they are rotated.
+func NewAdminServer(kv *KeyValueStore, encryption EncryptionOptions) *AdminServer {
+	return &AdminServer{kv: kv, encryption: encryption}
+}
+
+// TakeSnapshot takes a snapshot of the store now.
+func (s *AdminServer) TakeSnapshot(_ context.Context, _ *proto.TakeSnapshotRequest) (*proto.TakeSnapshotResponse, error) {
+	info, err := s.kv.TakeSnapshot()
+	if err != nil {
+		return nil, toStatus("take snapshot", err)
+	}
+
+	return &proto.TakeSnapshotResponse{Snapshot: snapshotInfoToProto(info)}, nil
+}
+
+// ListSnapshots lists the snapshots on disk, oldest first.
+func (s *AdminServer) ListSnapshots(_ context.Context, _ *proto.ListSnapshotsRequest) (*proto.ListSnapshotsResponse, error) {
+	snapshots, err := s.kv.Snapshots()
+	if err != nil {
+		return nil, toStatus("list snapshots", err)
+	}
+
+	resp := &proto.ListSnapshotsResponse{Snapshots: make([]*proto.SnapshotInfo, len(snapshots))}
+	for i, info := range snapshots {
+		resp.Snapshots[i] = snapshotInfoToProto(info)
+	}
+
+	return resp, nil
+}
+
+// DeleteSnapshot deletes a snapshot by name.
+func (s *AdminServer) DeleteSnapshot(_ context.Context, req *proto.DeleteSnapshotRequest) (*proto.DeleteSnapshotResponse, error) {
+	if req.GetName() == "" {
+		return nil, toStatus("delete snapshot", invalidField("name", "is required"))
+	}
+
+	if err := s.kv.DeleteSnapshot(req.GetName()); err != nil {
+		return nil, toStatus("delete snapshot", err)
+	}
+
+	return &proto.DeleteSnapshotResponse{}, nil
+}
+
+// RestoreSnapshot replaces the contents of the store with a snapshot.
+func (s *AdminServer) RestoreSnapshot(_ context.Context, req *proto.RestoreSnapshotRequest) (*proto.RestoreSnapshotResponse, error) {
+	if req.GetName() == "" {
+		return nil, toStatus("restore snapshot", invalidField("name", "is required"))
+	}
+
+	revision, err := s.kv.RestoreSnapshot(req.GetName())
+	if err != nil {
+		return nil, toStatus("restore snapshot", err)
+	}
+
+	return &proto.RestoreSnapshotResponse{Revision: revision}, nil
+}
+
+// Backup streams a consistent copy of the store in the snapshot format.
+func (s *AdminServer) Backup(req *proto.BackupRequest, stream grpc.ServerStreamingServer[proto.BackupChunk]) error {
+	compression := CompressionZstd
+	if req.GetCompression() != "" {
+		var err error
+		if compression, err = ParseSnapshotCompression(req.GetCompression()); err != nil {
+			return toStatus("backup", invalidField("compression", "%v", err))
+		}
+	}
+
+	// Chunks are buffered up to backupChunkSize, and larger writes are split
+	w := bufio.NewWriterSize(chunkWriter(func(chunk []byte) error {
+		return stream.Send(&proto.BackupChunk{Data: chunk})
+	}), backupChunkSize)
+	if err := s.kv.Backup(w, compression); err != nil {
+		return toStatus("backup", err)
+	}
+
+	return toStatus("backup", w.Flush())
+}
+
+// Restore reads a backup streamed by the client and applies it once it is complete.
+func (s *AdminServer) Restore(stream grpc.ClientStreamingServer[proto.RestoreRequest, proto.RestoreResponse]) error {
+	first, err := stream.Recv()
+	if err != nil && !errors.Is(err, io.EOF) {
+		return err
+	}
+
+	mode := RestoreReplace
+	if first.GetMode() == proto.RestoreRequest_MERGE {
+		mode = RestoreMerge
+	}
+
+	r := &restoreReader{stream: stream, data: first.GetData(), done: first == nil}
+	revision, err := s.kv.Restore(r, mode)
+	if errors.Is(err, ErrCorruptSnapshot) {
+		// The backup came from the client, so a damaged one is a bad request
+		err = &FieldError{Field: "data", Err: fmt.Errorf("%w: %w", ErrInvalidArgument, err)}
+	}
+	if err != nil {
+		return toStatus("restore", err)
+	}
+
+	return stream.SendAndClose(&proto.RestoreResponse{Revision: revision})
+}
+
+// RotateKeys reloads the encryption keys from their source and starts encrypting
+// new files with the active key.
+func (s *AdminServer) RotateKeys(_ context.Context, _ *proto.RotateKeysRequest) (*proto.RotateKeysResponse, error) {
+	if !s.encryption.Enabled() {
+		return nil, toStatus("rotate keys", ErrEncryptionDisabled)
+	}
+
+	keys, err := s.encryption.LoadKeyring()
+	if err != nil {
+		return nil, toStatus("rotate keys", err)
+	}
+
+	if err = s.kv.RotateKeys(keys); err != nil {
+		return nil, toStatus("rotate keys", err)
+	}
+
+	return &proto.RotateKeysResponse{ActiveKeyId: keys.ActiveKeyID()}, nil
+}
+
+// chunkWriter sends what is written to it in chunks of at most backupChunkSize bytes.
+type chunkWriter func(chunk []byte) error
+
+func (send chunkWriter) Write(p []byte) (int, error) {
+	written := 0
+	for len(p) > 0 {
+		n := min(len(p), backupChunkSize)
+		// The chunk is copied, as the caller may reuse p once Write returns
+		if err := send(bytes.Clone(p[:n])); err != nil {
+			return written, err
+		}
+		written += n
+		p = p[n:]
+	}
+
+	return written, nil
+}
+
+// restoreReader reads the backup streamed to the Restore RPC.
+type restoreReader struct {
+	stream grpc.ClientStreamingServer[proto.RestoreRequest, proto.RestoreResponse]
+	data   []byte // the part of the last message that hasn't been read yet
+	done   bool
+}
+
+func (r *restoreReader) Read(p []byte) (int, error) {
+	for len(r.data) == 0 {
+		if r.done {
+			return 0, io.EOF
+		}
+
+		req, err := r.stream.Recv()
+		if errors.Is(err, io.EOF) {
+			r.done = true
+			continue
+		}
+		if err != nil {
+			return 0, err
+		}
+		r.data = req.GetData()
+	}
+
+	n := copy(p, r.data)
+	r.data = r.data[n:]
+
+	return n, nil
+}
+
+// snapshotInfoToProto converts a SnapshotInfo into its protobuf representation.
+func snapshotInfoToProto(info SnapshotInfo) *proto.SnapshotInfo {
+	return &proto.SnapshotInfo{
+		Name:        info.Name,
+		SizeBytes:   info.Size,
+		TimestampMs: info.Timestamp.UnixMilli(),
+		Revision:    info.Revision,
+		KeyCount:    info.Keys,
+		LogSegment:  info.LogPosition.Segment,
+		LogOffset:   info.LogPosition.Offset,
+		Corrupt:     info.Corrupt,
+		KeyId:       info.KeyID,
+	}
+}
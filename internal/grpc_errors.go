@@ -0,0 +1,128 @@
+package keyvaluestore
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/protoadapt"
+)
+
+// errorDomain is the domain reported in the ErrorInfo details of gRPC errors.
+const errorDomain = "herd"
+
+// classify returns the gRPC status code for err and the reason reported in its ErrorInfo.
+// More specific errors are checked before the errors they wrap.
+func classify(err error) (codes.Code, string) {
+	switch {
+	case errors.Is(err, ErrKeyNotFound):
+		return codes.NotFound, "KEY_NOT_FOUND"
+	case errors.Is(err, ErrKeyExists):
+		return codes.FailedPrecondition, "KEY_EXISTS"
+	case errors.Is(err, ErrVersionMismatch):
+		return codes.FailedPrecondition, "VERSION_MISMATCH"
+	case errors.Is(err, ErrInvalidScan):
+		return codes.InvalidArgument, "INVALID_SCAN"
+	case errors.Is(err, ErrInvalidArgument):
+		return codes.InvalidArgument, "INVALID_ARGUMENT"
+	case errors.Is(err, ErrNotJSON):
+		return codes.FailedPrecondition, "VALUE_NOT_JSON"
+	case errors.Is(err, ErrPathNotFound):
+		return codes.NotFound, "PATH_NOT_FOUND"
+	case errors.Is(err, ErrPatchTestFailed):
+		return codes.FailedPrecondition, "PATCH_TEST_FAILED"
+	case errors.Is(err, ErrOutOfMemory):
+		return codes.ResourceExhausted, "OUT_OF_MEMORY"
+	case errors.Is(err, ErrCompacted):
+		return codes.OutOfRange, "REVISION_COMPACTED"
+	case errors.Is(err, ErrWatcherTooSlow):
+		return codes.ResourceExhausted, "WATCHER_TOO_SLOW"
+	case errors.Is(err, ErrCorruptSnapshot):
+		return codes.DataLoss, "SNAPSHOT_CORRUPT"
+	case errors.Is(err, ErrCorruptLog):
+		return codes.DataLoss, "LOG_CORRUPT"
+	case errors.Is(err, ErrWrongType):
+		return codes.FailedPrecondition, "WRONG_TYPE"
+	case errors.Is(err, ErrNotNumber):
+		return codes.FailedPrecondition, "VALUE_NOT_NUMBER"
+	case errors.Is(err, ErrOverflow):
+		return codes.OutOfRange, "COUNTER_OVERFLOW"
+	case errors.Is(err, ErrIndexNotFound):
+		return codes.NotFound, "INDEX_NOT_FOUND"
+	case errors.Is(err, ErrIndexExists):
+		return codes.AlreadyExists, "INDEX_EXISTS"
+	case errors.Is(err, ErrSnapshotNotFound):
+		return codes.NotFound, "SNAPSHOT_NOT_FOUND"
+	case errors.Is(err, ErrSnapshotRequired):
+		return codes.FailedPrecondition, "SNAPSHOT_REQUIRED"
+	case errors.Is(err, ErrLoggingDisabled):
+		return codes.FailedPrecondition, "LOGGING_DISABLED"
+	case errors.Is(err, ErrEncryptionDisabled):
+		return codes.FailedPrecondition, "ENCRYPTION_DISABLED"
+	case errors.Is(err, ErrUnknownKey):
+		return codes.FailedPrecondition, "ENCRYPTION_KEY_UNAVAILABLE"
+	case errors.Is(err, ErrLogBackpressure):
+		return codes.Unavailable, "LOG_BACKPRESSURE"
+	default:
+		return codes.Internal, "INTERNAL"
+	}
+}
+
+// toStatus converts an error from the store into a gRPC status error. The status code is
+// chosen from the sentinel error it wraps, and the offending key or request field is
+// attached as error details so clients don't have to parse the message.
+func toStatus(op string, err error) error {
+	if err == nil {
+		return nil
+	}
+
+	if _, ok := status.FromError(err); ok {
+		return err
+	}
+
+	if errors.Is(err, context.Canceled) || errors.Is(err, context.DeadlineExceeded) {
+		return status.FromContextError(err).Err()
+	}
+
+	code, reason := classify(err)
+	st := status.New(code, fmt.Sprintf("%s: %v", op, err))
+	info := &errdetails.ErrorInfo{Reason: reason, Domain: errorDomain, Metadata: map[string]string{}}
+	details := []protoadapt.MessageV1{info}
+
+	var keyErr *KeyError
+	if errors.As(err, &keyErr) {
+		info.Metadata["key"] = keyErr.Key
+
+		switch code {
+		case codes.NotFound:
+			details = append(details, &errdetails.ResourceInfo{ResourceType: "key", ResourceName: keyErr.Key})
+		case codes.FailedPrecondition:
+			details = append(details, &errdetails.PreconditionFailure{
+				Violations: []*errdetails.PreconditionFailure_Violation{
+					{Type: reason, Subject: keyErr.Key, Description: keyErr.Err.Error()},
+				},
+			})
+		default:
+		}
+	}
+
+	var fieldErr *FieldError
+	if errors.As(err, &fieldErr) {
+		info.Metadata["field"] = fieldErr.Field
+		details = append(details, &errdetails.BadRequest{
+			FieldViolations: []*errdetails.BadRequest_FieldViolation{
+				{Field: fieldErr.Field, Description: fieldErr.Err.Error()},
+			},
+		})
+	}
+
+	withDetails, detailsErr := st.WithDetails(details...)
+	if detailsErr != nil {
+		return st.Err()
+	}
+
+	return withDetails.Err()
+}
@@ -1,181 +1,829 @@
-package keyvaluestore
-
-import (
-	"context"
-	"crypto/tls"
-	"crypto/x509"
-	"fmt"
-	"log"
-	"net"
-	"os"
-	"time"
-
-	"github.com/defoeam/herd/api/proto"
-	"google.golang.org/grpc"
-	"google.golang.org/grpc/credentials"
-)
-
-type GRPCServer struct {
-	proto.UnimplementedKeyValueServiceServer
-	kv *KeyValueStore
-}
-
-// NewGRPCServer creates a new gRPC server with an empty key-value store.
-func NewGRPCServer() *GRPCServer {
-	return &GRPCServer{
-		kv: NewKeyValueStore(),
-	}
-}
-
-// Get returns an item in the key-value store by key.
-func (s *GRPCServer) Get(_ context.Context, req *proto.GetRequest) (*proto.KeyValue, error) {
-	value, ok := s.kv.Get(req.GetKey())
-	if !ok {
-		return nil, fmt.Errorf("key not found: %s", req.GetKey())
-	}
-
-	return &proto.KeyValue{
-		Key:   req.GetKey(),
-		Value: value,
-	}, nil
-}
-
-// GetAll returns all items in the key-value store.
-func (s *GRPCServer) GetAll(_ context.Context, _ *proto.GetAllRequest) (*proto.GetAllResponse, error) {
-	data := s.kv.GetAll()
-	items := make([]*proto.KeyValue, 0, len(data))
-
-	for k, v := range data {
-		items = append(items, &proto.KeyValue{
-			Key:   k,
-			Value: v,
-		})
-	}
-
-	return &proto.GetAllResponse{Items: items}, nil
-}
-
-// GetKeys returns all keys in the key-value store.
-func (s *GRPCServer) GetKeys(_ context.Context, _ *proto.GetKeysRequest) (*proto.GetKeysResponse, error) {
-	keys := s.kv.GetKeys()
-	return &proto.GetKeysResponse{
-		Keys: keys,
-	}, nil
-}
-
-// GetValues returns all values in the key-value store.
-func (s *GRPCServer) GetValues(_ context.Context, _ *proto.GetValuesRequest) (*proto.GetValuesResponse, error) {
-	values := s.kv.GetValues()
-	byteValues := make([][]byte, len(values))
-	for i, v := range values {
-		byteValues[i] = []byte(v)
-	}
-	return &proto.GetValuesResponse{
-		Values: byteValues,
-	}, nil
-}
-
-// Set sets an item in the key-value store by key and value.
-func (s *GRPCServer) Set(_ context.Context, req *proto.SetRequest) (*proto.SetResponse, error) {
-	s.kv.Set(req.GetKey(), req.GetValue())
-
-	return &proto.SetResponse{
-		Item: &proto.KeyValue{
-			Key:   req.GetKey(),
-			Value: req.GetValue(),
-		},
-	}, nil
-}
-
-// Delete deletes an item in the key-value store by key.
-func (s *GRPCServer) Delete(_ context.Context, req *proto.DeleteRequest) (*proto.DeleteResponse, error) {
-	value, ok := s.kv.Delete(req.GetKey())
-	if !ok {
-		return nil, fmt.Errorf("key not found: %s", req.GetKey())
-	}
-
-	return &proto.DeleteResponse{
-		DeletedItem: &proto.KeyValue{
-			Key:   req.GetKey(),
-			Value: value,
-		},
-	}, nil
-}
-
-// DeleteAll deletes all items in the key-value store.
-func (s *GRPCServer) DeleteAll(_ context.Context, _ *proto.DeleteAllRequest) (*proto.DeleteAllResponse, error) {
-	if err := s.kv.DeleteALL(); err != nil {
-		return nil, fmt.Errorf("failed to clear all items: %w", err)
-	}
-	return &proto.DeleteAllResponse{}, nil
-}
-
-// StartGRPCServer starts a gRPC server on port 50051.
-// If enableLogging is true, it initializes logging to the specified file with a rotation interval of 1 hour.
-func StartGRPCServer(enableLogging bool, enableSecurity bool) error {
-	log.Printf("Starting server on port 7878...")
-
-	// initialize the keyvalue store and logging
-	server := NewGRPCServer()
-	if enableLogging {
-		if err := server.kv.InitLogging("/app/log/transaction.log", 1*time.Hour); err != nil {
-			return fmt.Errorf("failed to initialize logging: %w", err)
-		}
-	}
-
-	// create a new gRPC server with or without tls
-	s, serverFactoryErr := grpcServerFactory(enableSecurity)
-	if serverFactoryErr != nil {
-		return fmt.Errorf("failed to create server: %w", serverFactoryErr)
-	}
-
-	// register the KeyValueService server
-	proto.RegisterKeyValueServiceServer(s, server)
-
-	// setup listener
-	lis, listenErr := net.Listen("tcp", "0.0.0.0:7878")
-	if listenErr != nil {
-		return fmt.Errorf("failed to listen: %w", listenErr)
-	}
-
-	// serve the gRPC server
-	serveErr := s.Serve(lis)
-	if serveErr != nil {
-		return fmt.Errorf("failed to serve: %w", serveErr)
-	}
-	return nil
-}
-
-// grpcServerFactory creates a new gRPC server with or without security enabled.
-func grpcServerFactory(enableSecurity bool) (*grpc.Server, error) {
-	if enableSecurity {
-		// load the server's certificate and private key
-		cert, certPairErr := tls.LoadX509KeyPair("certs/server.crt", "certs/server.key")
-		if certPairErr != nil {
-			return nil, fmt.Errorf("failed to load X509 key pair: %w", certPairErr)
-		}
-
-		// setup and load the CA's certificate
-		ca := x509.NewCertPool()
-		caFilePath := "certs/ca.crt"
-		caBytes, caBytesErr := os.ReadFile(caFilePath)
-		if caBytesErr != nil {
-			return nil, fmt.Errorf("failed to read CA certificate: %w", caBytesErr)
-		}
-		if ok := ca.AppendCertsFromPEM(caBytes); !ok {
-			return nil, fmt.Errorf("failed to append CA certificate")
-		}
-
-		// create a new TLS configuration with the server's certificate and the CA's certificate
-		tlsConfig := &tls.Config{
-			ClientAuth:   tls.RequireAndVerifyClientCert,
-			Certificates: []tls.Certificate{cert},
-			ClientCAs:    ca,
-		}
-
-		// create a new gRPC server with the TLS configuration
-		return grpc.NewServer(grpc.Creds(credentials.NewTLS(tlsConfig))), nil
-	}
-
-	return grpc.NewServer(), nil
-}
+package keyvaluestore
+
+import (
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"fmt"
+	"log"
+	"net"
+	"os"
+	"time"
+
+	"github.com/defoeam/herd/api/proto"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials"
+)
+
+const (
+	// LogFile is where the server writes its transaction log and snapshots when logging is enabled.
+	LogFile = "/app/log/transaction.log"
+
+	// expiryReapInterval is how often expired keys are removed in the background.
+	expiryReapInterval = 1 * time.Second
+
+	// defaultStreamChunkSize is how many entries are sent per message by the streaming RPCs.
+	defaultStreamChunkSize = 1000
+)
+
+type GRPCServer struct {
+	proto.UnimplementedKeyValueServiceServer
+	kv *KeyValueStore
+}
+
+// NewGRPCServer creates a new gRPC server with an empty key-value store.
+func NewGRPCServer() *GRPCServer {
+	return &GRPCServer{
+		kv: NewKeyValueStore(),
+	}
+}
+
+// Get returns an item in the key-value store by key.
+func (s *GRPCServer) Get(_ context.Context, req *proto.GetRequest) (*proto.KeyValue, error) {
+	item, ok := s.kv.GetItem(req.GetKey())
+	if !ok {
+		return nil, toStatus("get", &KeyError{Key: req.GetKey(), Err: ErrKeyNotFound})
+	}
+
+	return scanItemToProto(item), nil
+}
+
+// GetAll returns all items in the key-value store.
+func (s *GRPCServer) GetAll(_ context.Context, _ *proto.GetAllRequest) (*proto.GetAllResponse, error) {
+	data := s.kv.GetAllItems()
+	items := make([]*proto.KeyValue, len(data))
+	for i, item := range data {
+		items[i] = scanItemToProto(item)
+	}
+
+	return &proto.GetAllResponse{Items: items}, nil
+}
+
+// scanItemToProto converts an item read from the store to its gRPC representation.
+func scanItemToProto(item ScanItem) *proto.KeyValue {
+	return &proto.KeyValue{Key: item.Key, Value: item.Value, Version: item.Version, ContentType: item.ContentType}
+}
+
+// GetKeys returns all keys in the key-value store.
+func (s *GRPCServer) GetKeys(_ context.Context, _ *proto.GetKeysRequest) (*proto.GetKeysResponse, error) {
+	keys := s.kv.GetKeys()
+	return &proto.GetKeysResponse{
+		Keys: keys,
+	}, nil
+}
+
+// GetValues returns all values in the key-value store.
+func (s *GRPCServer) GetValues(_ context.Context, _ *proto.GetValuesRequest) (*proto.GetValuesResponse, error) {
+	values := s.kv.GetValues()
+	byteValues := make([][]byte, len(values))
+	for i, v := range values {
+		byteValues[i] = []byte(v)
+	}
+	return &proto.GetValuesResponse{
+		Values: byteValues,
+	}, nil
+}
+
+// Scan returns a page of items in lexicographic key order.
+func (s *GRPCServer) Scan(_ context.Context, req *proto.ScanRequest) (*proto.ScanResponse, error) {
+	result, err := s.kv.Scan(ScanOptions{
+		Prefix:            req.GetPrefix(),
+		Start:             req.GetStart(),
+		End:               req.GetEnd(),
+		Limit:             int(req.GetLimit()),
+		ContinuationToken: req.GetContinuationToken(),
+	})
+	if err != nil {
+		return nil, toStatus("scan", err)
+	}
+
+	return &proto.ScanResponse{
+		Items:             scanResultToProto(result, req.GetKeysOnly()),
+		ContinuationToken: result.ContinuationToken,
+	}, nil
+}
+
+// QueryIndex returns a page of items selected by a secondary index, ordered by the indexed field.
+func (s *GRPCServer) QueryIndex(_ context.Context, req *proto.QueryIndexRequest) (*proto.QueryIndexResponse, error) {
+	result, err := s.kv.QueryIndex(IndexQuery{
+		Index:             req.GetIndex(),
+		Equal:             jsonFromProto(req.GetEqual()),
+		Start:             jsonFromProto(req.GetStart()),
+		End:               jsonFromProto(req.GetEnd()),
+		Limit:             int(req.GetLimit()),
+		ContinuationToken: req.GetContinuationToken(),
+	})
+	if err != nil {
+		return nil, toStatus("query index", err)
+	}
+
+	return &proto.QueryIndexResponse{
+		Items:             scanResultToProto(result, req.GetKeysOnly()),
+		ContinuationToken: result.ContinuationToken,
+	}, nil
+}
+
+// scanResultToProto converts a page of items, leaving out the values if keysOnly is set.
+func scanResultToProto(result ScanResult, keysOnly bool) []*proto.KeyValue {
+	items := make([]*proto.KeyValue, len(result.Items))
+	for i, item := range result.Items {
+		items[i] = &proto.KeyValue{Key: item.Key, Version: item.Version}
+		if !keysOnly {
+			items[i].Value = item.Value
+			items[i].ContentType = item.ContentType
+		}
+	}
+
+	return items
+}
+
+// jsonFromProto converts an optional JSON value from a request, where an unset field is empty.
+func jsonFromProto(value []byte) []byte {
+	if len(value) == 0 {
+		return nil
+	}
+
+	return value
+}
+
+// StreamAll streams all items in the key-value store as of the time of the request.
+func (s *GRPCServer) StreamAll(req *proto.StreamRequest, stream grpc.ServerStreamingServer[proto.GetAllResponse]) error {
+	return streamChunks(s.kv.View(), req.GetChunkSize(), func(chunk []ScanItem) error {
+		items := make([]*proto.KeyValue, len(chunk))
+		for i, item := range chunk {
+			items[i] = scanItemToProto(item)
+		}
+		return stream.Send(&proto.GetAllResponse{Items: items})
+	})
+}
+
+// StreamKeys streams all keys in the key-value store as of the time of the request.
+func (s *GRPCServer) StreamKeys(req *proto.StreamRequest, stream grpc.ServerStreamingServer[proto.GetKeysResponse]) error {
+	return streamChunks(s.kv.View(), req.GetChunkSize(), func(chunk []ScanItem) error {
+		keys := make([]string, len(chunk))
+		for i, item := range chunk {
+			keys[i] = item.Key
+		}
+		return stream.Send(&proto.GetKeysResponse{Keys: keys})
+	})
+}
+
+// StreamValues streams all values in the key-value store as of the time of the request.
+func (s *GRPCServer) StreamValues(req *proto.StreamRequest, stream grpc.ServerStreamingServer[proto.GetValuesResponse]) error {
+	return streamChunks(s.kv.View(), req.GetChunkSize(), func(chunk []ScanItem) error {
+		values := make([][]byte, len(chunk))
+		for i, item := range chunk {
+			values[i] = item.Value
+		}
+		return stream.Send(&proto.GetValuesResponse{Values: values})
+	})
+}
+
+// streamChunks walks view in key order and passes its items to send in chunks of chunkSize,
+// which is capped at maxScanLimit. The store is not locked while sending, so slow clients
+// don't block writers.
+func streamChunks(view *View, chunkSize uint32, send func(chunk []ScanItem) error) error {
+	size := defaultStreamChunkSize
+	if chunkSize > 0 {
+		size = int(min(chunkSize, maxScanLimit))
+	}
+
+	var sendErr error
+	chunk := make([]ScanItem, 0, min(size, view.len()))
+	view.Ascend(func(item ScanItem) bool {
+		chunk = append(chunk, item)
+		if len(chunk) < size {
+			return true
+		}
+
+		sendErr = send(chunk)
+		chunk = chunk[:0]
+		return sendErr == nil
+	})
+
+	if sendErr != nil {
+		return sendErr
+	}
+
+	if len(chunk) > 0 {
+		return send(chunk)
+	}
+	return nil
+}
+
+// Set sets an item in the key-value store by key and value, with an optional TTL and version preconditions.
+func (s *GRPCServer) Set(_ context.Context, req *proto.SetRequest) (*proto.SetResponse, error) {
+	ttl, ttlErr := ttlFromProto("ttl_ms", req.GetTtlMs())
+	if ttlErr != nil {
+		return nil, toStatus("set", ttlErr)
+	}
+
+	version, err := s.kv.SetWithOptions(req.GetKey(), req.GetValue(), SetOptions{
+		TTL:         ttl,
+		IfVersion:   req.GetIfVersion(),
+		IfAbsent:    req.GetIfAbsent(),
+		ContentType: req.GetContentType(),
+	})
+	if err != nil {
+		return nil, toStatus("set", err)
+	}
+
+	return &proto.SetResponse{
+		Item: &proto.KeyValue{
+			Key:         req.GetKey(),
+			Value:       req.GetValue(),
+			Version:     version,
+			ContentType: req.GetContentType(),
+		},
+	}, nil
+}
+
+// CompareAndSwap sets an item in the key-value store only if it is at the expected version.
+func (s *GRPCServer) CompareAndSwap(_ context.Context, req *proto.CompareAndSwapRequest) (*proto.CompareAndSwapResponse, error) {
+	ttl, ttlErr := ttlFromProto("ttl_ms", req.GetTtlMs())
+	if ttlErr != nil {
+		return nil, toStatus("compare and swap", ttlErr)
+	}
+
+	opts := SetOptions{TTL: ttl, ContentType: req.GetContentType()}
+	version, err := s.kv.compareAndSwap(req.GetKey(), req.GetExpectedVersion(), req.GetValue(), opts)
+	if err != nil {
+		return nil, toStatus("compare and swap", err)
+	}
+
+	return &proto.CompareAndSwapResponse{
+		Item: &proto.KeyValue{
+			Key:         req.GetKey(),
+			Value:       req.GetValue(),
+			Version:     version,
+			ContentType: req.GetContentType(),
+		},
+	}, nil
+}
+
+// ttlFromProto converts a TTL in milliseconds from the request field into a duration.
+func ttlFromProto(field string, ttlMs int64) (time.Duration, error) {
+	if ttlMs < 0 {
+		return 0, invalidField(field, "ttl must not be negative: %d", ttlMs)
+	}
+
+	return time.Duration(ttlMs) * time.Millisecond, nil
+}
+
+// GetPath returns the part of a JSON item in the key-value store selected by a path.
+func (s *GRPCServer) GetPath(_ context.Context, req *proto.GetPathRequest) (*proto.KeyValue, error) {
+	value, version, err := s.kv.GetPath(req.GetKey(), req.GetPath())
+	if err != nil {
+		return nil, toStatus("get path", err)
+	}
+
+	return &proto.KeyValue{Key: req.GetKey(), Value: value, Version: version, ContentType: ContentTypeJSON}, nil
+}
+
+// Patch applies a JSON Patch to a JSON item in the key-value store.
+func (s *GRPCServer) Patch(_ context.Context, req *proto.PatchRequest) (*proto.PatchResponse, error) {
+	item, err := s.kv.Patch(req.GetKey(), req.GetPatch())
+	if err != nil {
+		return nil, toStatus("patch", err)
+	}
+
+	return &proto.PatchResponse{Item: scanItemToProto(item)}, nil
+}
+
+// Merge applies a JSON Merge Patch to a JSON item in the key-value store.
+func (s *GRPCServer) Merge(_ context.Context, req *proto.MergeRequest) (*proto.MergeResponse, error) {
+	item, err := s.kv.Merge(req.GetKey(), req.GetPatch())
+	if err != nil {
+		return nil, toStatus("merge", err)
+	}
+
+	return &proto.MergeResponse{Item: scanItemToProto(item)}, nil
+}
+
+// Increment atomically adds to a counter in the key-value store.
+func (s *GRPCServer) Increment(_ context.Context, req *proto.IncrementRequest) (*proto.IncrementResponse, error) {
+	return s.increment("increment", req, s.kv.Increment)
+}
+
+// Decrement atomically subtracts from a counter in the key-value store.
+func (s *GRPCServer) Decrement(_ context.Context, req *proto.IncrementRequest) (*proto.IncrementResponse, error) {
+	return s.increment("decrement", req, s.kv.Decrement)
+}
+
+// increment runs an Increment or Decrement request with apply.
+func (s *GRPCServer) increment(
+	op string,
+	req *proto.IncrementRequest,
+	apply func(key string, delta Number, opts IncrementOptions) (Number, ScanItem, error),
+) (*proto.IncrementResponse, error) {
+	ttl, ttlErr := ttlFromProto("ttl_ms", req.GetTtlMs())
+	if ttlErr != nil {
+		return nil, toStatus(op, ttlErr)
+	}
+
+	delta := IntNumber(1)
+	switch d := req.GetDelta().(type) {
+	case *proto.IncrementRequest_IntDelta:
+		delta = IntNumber(d.IntDelta)
+	case *proto.IncrementRequest_FloatDelta:
+		delta = FloatNumber(d.FloatDelta)
+	}
+
+	var opts IncrementOptions
+	switch initial := req.GetInitial().(type) {
+	case *proto.IncrementRequest_IntInitial:
+		opts.Initial = IntNumber(initial.IntInitial)
+	case *proto.IncrementRequest_FloatInitial:
+		opts.Initial = FloatNumber(initial.FloatInitial)
+	}
+	opts.TTL = ttl
+
+	result, item, err := apply(req.GetKey(), delta, opts)
+	if err != nil {
+		return nil, toStatus(op, err)
+	}
+
+	res := &proto.IncrementResponse{Item: scanItemToProto(item)}
+	if result.IsFloat {
+		res.Result = &proto.IncrementResponse_FloatValue{FloatValue: result.Float}
+	} else {
+		res.Result = &proto.IncrementResponse_IntValue{IntValue: result.Int}
+	}
+
+	return res, nil
+}
+
+// ListPush adds values to either end of a list in the key-value store.
+func (s *GRPCServer) ListPush(_ context.Context, req *proto.ListPushRequest) (*proto.ListPushResponse, error) {
+	length, err := s.kv.ListPush(req.GetKey(), req.GetValues(), req.GetFront())
+	if err != nil {
+		return nil, toStatus("list push", err)
+	}
+
+	return &proto.ListPushResponse{Length: uint64(length)}, nil
+}
+
+// ListPop removes values from either end of a list in the key-value store.
+func (s *GRPCServer) ListPop(_ context.Context, req *proto.ListPopRequest) (*proto.ListPopResponse, error) {
+	values, err := s.kv.ListPop(req.GetKey(), int(req.GetCount()), req.GetFront())
+	if err != nil {
+		return nil, toStatus("list pop", err)
+	}
+
+	return &proto.ListPopResponse{Values: values}, nil
+}
+
+// ListRange returns part of a list in the key-value store.
+func (s *GRPCServer) ListRange(_ context.Context, req *proto.ListRangeRequest) (*proto.ListRangeResponse, error) {
+	values, err := s.kv.ListRange(req.GetKey(), int(req.GetStart()), int(req.GetStop()))
+	if err != nil {
+		return nil, toStatus("list range", err)
+	}
+
+	return &proto.ListRangeResponse{Values: values}, nil
+}
+
+// SetAdd adds members to a set in the key-value store.
+func (s *GRPCServer) SetAdd(_ context.Context, req *proto.SetAddRequest) (*proto.SetAddResponse, error) {
+	added, err := s.kv.SetAdd(req.GetKey(), req.GetMembers())
+	if err != nil {
+		return nil, toStatus("set add", err)
+	}
+
+	return &proto.SetAddResponse{Added: uint64(added)}, nil
+}
+
+// SetRemove removes members from a set in the key-value store.
+func (s *GRPCServer) SetRemove(_ context.Context, req *proto.SetRemoveRequest) (*proto.SetRemoveResponse, error) {
+	removed, err := s.kv.SetRemove(req.GetKey(), req.GetMembers())
+	if err != nil {
+		return nil, toStatus("set remove", err)
+	}
+
+	return &proto.SetRemoveResponse{Removed: uint64(removed)}, nil
+}
+
+// SetMembers returns the members of a set in the key-value store.
+func (s *GRPCServer) SetMembers(_ context.Context, req *proto.SetMembersRequest) (*proto.SetMembersResponse, error) {
+	members, err := s.kv.SetMembers(req.GetKey())
+	if err != nil {
+		return nil, toStatus("set members", err)
+	}
+
+	return &proto.SetMembersResponse{Members: members}, nil
+}
+
+// HashSet sets fields of a hash in the key-value store.
+func (s *GRPCServer) HashSet(_ context.Context, req *proto.HashSetRequest) (*proto.HashSetResponse, error) {
+	fields := make(map[string][]byte, len(req.GetFields()))
+	for _, field := range req.GetFields() {
+		fields[field.GetField()] = field.GetValue()
+	}
+
+	added, err := s.kv.HashSet(req.GetKey(), fields)
+	if err != nil {
+		return nil, toStatus("hash set", err)
+	}
+
+	return &proto.HashSetResponse{Added: uint64(added)}, nil
+}
+
+// HashGet returns a field of a hash in the key-value store.
+func (s *GRPCServer) HashGet(_ context.Context, req *proto.HashGetRequest) (*proto.HashGetResponse, error) {
+	value, found, err := s.kv.HashGet(req.GetKey(), req.GetField())
+	if err != nil {
+		return nil, toStatus("hash get", err)
+	}
+
+	return &proto.HashGetResponse{Value: value, Found: found}, nil
+}
+
+// HashGetAll returns every field of a hash in the key-value store.
+func (s *GRPCServer) HashGetAll(_ context.Context, req *proto.HashGetAllRequest) (*proto.HashGetAllResponse, error) {
+	hash, err := s.kv.HashGetAll(req.GetKey())
+	if err != nil {
+		return nil, toStatus("hash get all", err)
+	}
+
+	fields := make([]*proto.HashField, 0, len(hash))
+	for _, field := range sortedKeys(hash) {
+		fields = append(fields, &proto.HashField{Field: field, Value: hash[field]})
+	}
+
+	return &proto.HashGetAllResponse{Fields: fields}, nil
+}
+
+// SortedSetAdd adds members to a sorted set in the key-value store.
+func (s *GRPCServer) SortedSetAdd(_ context.Context, req *proto.SortedSetAddRequest) (*proto.SortedSetAddResponse, error) {
+	members := make([]ScoredMember, len(req.GetMembers()))
+	for i, member := range req.GetMembers() {
+		members[i] = ScoredMember{Member: member.GetMember(), Score: member.GetScore()}
+	}
+
+	added, err := s.kv.SortedSetAdd(req.GetKey(), members)
+	if err != nil {
+		return nil, toStatus("sorted set add", err)
+	}
+
+	return &proto.SortedSetAddResponse{Added: uint64(added)}, nil
+}
+
+// SortedSetRangeByScore returns the members of a sorted set in the key-value store within a range of scores.
+func (s *GRPCServer) SortedSetRangeByScore(_ context.Context, req *proto.SortedSetRangeByScoreRequest) (*proto.SortedSetRangeByScoreResponse, error) {
+	members, err := s.kv.SortedSetRangeByScore(req.GetKey(), req.GetMin(), req.GetMax(), int(req.GetLimit()))
+	if err != nil {
+		return nil, toStatus("sorted set range by score", err)
+	}
+
+	scored := make([]*proto.ScoredMember, len(members))
+	for i, member := range members {
+		scored[i] = &proto.ScoredMember{Member: member.Member, Score: member.Score}
+	}
+
+	return &proto.SortedSetRangeByScoreResponse{Members: scored}, nil
+}
+
+// Delete deletes an item in the key-value store by key.
+func (s *GRPCServer) Delete(_ context.Context, req *proto.DeleteRequest) (*proto.DeleteResponse, error) {
+	value, ok, err := s.kv.Delete(req.GetKey())
+	if err != nil {
+		return nil, toStatus("delete", err)
+	}
+	if !ok {
+		return nil, toStatus("delete", &KeyError{Key: req.GetKey(), Err: ErrKeyNotFound})
+	}
+
+	return &proto.DeleteResponse{
+		DeletedItem: &proto.KeyValue{
+			Key:   req.GetKey(),
+			Value: value,
+		},
+	}, nil
+}
+
+// DeleteAll deletes all items in the key-value store.
+func (s *GRPCServer) DeleteAll(_ context.Context, _ *proto.DeleteAllRequest) (*proto.DeleteAllResponse, error) {
+	if err := s.kv.DeleteALL(); err != nil {
+		return nil, toStatus("delete all", err)
+	}
+	return &proto.DeleteAllResponse{}, nil
+}
+
+// MultiGet returns several items in the key-value store at once.
+func (s *GRPCServer) MultiGet(_ context.Context, req *proto.MultiGetRequest) (*proto.MultiGetResponse, error) {
+	results := s.kv.MultiGet(req.GetKeys())
+
+	return &proto.MultiGetResponse{Results: keyValueResultsToProto(results)}, nil
+}
+
+// MultiSet atomically sets several items in the key-value store.
+func (s *GRPCServer) MultiSet(_ context.Context, req *proto.MultiSetRequest) (*proto.MultiSetResponse, error) {
+	ttl, ttlErr := ttlFromProto("ttl_ms", req.GetTtlMs())
+	if ttlErr != nil {
+		return nil, toStatus("multi set", ttlErr)
+	}
+
+	items := make([]KeyValue, len(req.GetItems()))
+	for i, item := range req.GetItems() {
+		items[i] = KeyValue{Key: item.GetKey(), Value: item.GetValue(), ContentType: item.GetContentType()}
+	}
+
+	version, err := s.kv.MultiSet(items, ttl)
+	if err != nil {
+		return nil, toStatus("multi set", err)
+	}
+
+	set := make([]*proto.KeyValue, len(items))
+	for i, item := range items {
+		set[i] = &proto.KeyValue{Key: item.Key, Value: item.Value, Version: version, ContentType: item.ContentType}
+	}
+
+	return &proto.MultiSetResponse{Items: set}, nil
+}
+
+// MultiDelete atomically deletes several items in the key-value store.
+func (s *GRPCServer) MultiDelete(_ context.Context, req *proto.MultiDeleteRequest) (*proto.MultiDeleteResponse, error) {
+	results, err := s.kv.MultiDelete(req.GetKeys())
+	if err != nil {
+		return nil, toStatus("multi delete", err)
+	}
+
+	return &proto.MultiDeleteResponse{Results: keyValueResultsToProto(results)}, nil
+}
+
+// keyValueResultsToProto converts the per-key results of a batch operation to their gRPC representation.
+func keyValueResultsToProto(results []TxnOpResult) []*proto.KeyValueResult {
+	converted := make([]*proto.KeyValueResult, len(results))
+	for i, r := range results {
+		converted[i] = &proto.KeyValueResult{
+			Item:  &proto.KeyValue{Key: r.Key, Value: r.Value, Version: r.Version, ContentType: r.ContentType},
+			Found: r.Found,
+		}
+	}
+
+	return converted
+}
+
+// Txn atomically runs then_ops if every compare holds, or else_ops otherwise.
+func (s *GRPCServer) Txn(_ context.Context, req *proto.TxnRequest) (*proto.TxnResponse, error) {
+	compares := make([]Compare, len(req.GetCompares()))
+	for i, c := range req.GetCompares() {
+		compare, err := compareFromProto(fmt.Sprintf("compares[%d]", i), c)
+		if err != nil {
+			return nil, toStatus("txn", err)
+		}
+		compares[i] = compare
+	}
+
+	thenOps, thenErr := txnOpsFromProto("then_ops", req.GetThenOps())
+	if thenErr != nil {
+		return nil, toStatus("txn", thenErr)
+	}
+
+	elseOps, elseErr := txnOpsFromProto("else_ops", req.GetElseOps())
+	if elseErr != nil {
+		return nil, toStatus("txn", elseErr)
+	}
+
+	result, err := s.kv.Txn(compares, thenOps, elseOps)
+	if err != nil {
+		return nil, toStatus("txn", err)
+	}
+
+	ops := thenOps
+	if !result.Succeeded {
+		ops = elseOps
+	}
+
+	responses := make([]*proto.TxnOpResponse, len(result.Results))
+	for i, r := range result.Results {
+		responses[i] = txnOpResponseToProto(ops[i].Type, r)
+	}
+
+	return &proto.TxnResponse{
+		Succeeded: result.Succeeded,
+		Responses: responses,
+		Revision:  result.Revision,
+	}, nil
+}
+
+// compareFromProto converts the transaction compare in field from its gRPC representation.
+func compareFromProto(field string, c *proto.Compare) (Compare, error) {
+	compare := Compare{Key: c.GetKey(), Version: c.GetVersion(), Value: c.GetValue()}
+
+	switch c.GetTarget() {
+	case proto.Compare_VERSION:
+		compare.Target = CompareVersion
+	case proto.Compare_VALUE:
+		compare.Target = CompareValue
+	default:
+		return Compare{}, invalidField(field+".target", "unknown target %v", c.GetTarget())
+	}
+
+	switch c.GetResult() {
+	case proto.Compare_EQUAL:
+		compare.Result = CompareEqual
+	case proto.Compare_NOT_EQUAL:
+		compare.Result = CompareNotEqual
+	case proto.Compare_GREATER:
+		compare.Result = CompareGreater
+	case proto.Compare_LESS:
+		compare.Result = CompareLess
+	default:
+		return Compare{}, invalidField(field+".result", "unknown result %v", c.GetResult())
+	}
+
+	return compare, nil
+}
+
+// txnOpsFromProto converts the transaction operations in field from their gRPC representation.
+func txnOpsFromProto(field string, ops []*proto.TxnOp) ([]TxnOp, error) {
+	converted := make([]TxnOp, len(ops))
+	for i, op := range ops {
+		opField := fmt.Sprintf("%s[%d]", field, i)
+		switch {
+		case op.GetGet() != nil:
+			converted[i] = TxnOp{Type: TxnGet, Key: op.GetGet().GetKey()}
+		case op.GetSet() != nil:
+			set := op.GetSet()
+			ttl, ttlErr := ttlFromProto(opField+".set.ttl_ms", set.GetTtlMs())
+			if ttlErr != nil {
+				return nil, ttlErr
+			}
+			if set.GetIfVersion() != 0 || set.GetIfAbsent() {
+				return nil, invalidField(opField+".set", "use compares instead of if_version and if_absent")
+			}
+			converted[i] = TxnOp{
+				Type:        TxnSet,
+				Key:         set.GetKey(),
+				Value:       set.GetValue(),
+				ContentType: set.GetContentType(),
+				TTL:         ttl,
+			}
+		case op.GetDelete() != nil:
+			converted[i] = TxnOp{Type: TxnDelete, Key: op.GetDelete().GetKey()}
+		default:
+			return nil, invalidField(opField, "no operation set")
+		}
+	}
+
+	return converted, nil
+}
+
+// txnOpResponseToProto converts the result of a transaction operation to its gRPC representation.
+func txnOpResponseToProto(opType TxnOpType, r TxnOpResult) *proto.TxnOpResponse {
+	item := &proto.KeyValue{Key: r.Key, Value: r.Value, Version: r.Version, ContentType: r.ContentType}
+	response := &proto.TxnOpResponse{Found: r.Found}
+
+	switch opType {
+	case TxnGet:
+		response.Response = &proto.TxnOpResponse_Get{Get: item}
+	case TxnSet:
+		response.Response = &proto.TxnOpResponse_Set{Set: &proto.SetResponse{Item: item}}
+	case TxnDelete:
+		response.Response = &proto.TxnOpResponse_Delete{Delete: &proto.DeleteResponse{DeletedItem: item}}
+	}
+
+	return response
+}
+
+// Watch streams changes to a key, a key prefix or the whole store until the client cancels.
+// Watchers that fall too far behind are cancelled with ResourceExhausted.
+func (s *GRPCServer) Watch(req *proto.WatchRequest, stream grpc.ServerStreamingServer[proto.WatchEvent]) error {
+	filter := WatchFilter{Key: req.GetKey(), Prefix: req.GetPrefix()}
+	w, err := s.kv.Watch(filter, req.GetStartRevision())
+	if err != nil {
+		return toStatus("watch", err)
+	}
+	defer w.Close()
+
+	for {
+		select {
+		case <-stream.Context().Done():
+			return toStatus("watch", stream.Context().Err())
+		case ev, ok := <-w.Events():
+			if !ok {
+				return toStatus("watch", w.Err())
+			}
+
+			if sendErr := stream.Send(watchEventToProto(ev)); sendErr != nil {
+				return sendErr
+			}
+		}
+	}
+}
+
+// watchEventToProto converts a store event to its gRPC representation.
+func watchEventToProto(ev Event) *proto.WatchEvent {
+	event := &proto.WatchEvent{Revision: ev.Revision}
+
+	switch ev.Type {
+	case EventSet:
+		event.Type = proto.WatchEvent_SET
+		event.Item = &proto.KeyValue{Key: ev.Key, Value: ev.Value, Version: ev.Revision, ContentType: ev.ContentType}
+	case EventDelete:
+		event.Type = proto.WatchEvent_DELETE
+		event.Item = &proto.KeyValue{Key: ev.Key, Value: ev.Value, ContentType: ev.ContentType}
+	case EventDeleteAll:
+		event.Type = proto.WatchEvent_DELETE_ALL
+	}
+
+	return event
+}
+
+// Config holds the options used to start the gRPC server.
+type Config struct {
+	EnableLogging  bool
+	EnableSecurity bool
+	MaxMemory      int64 // in bytes, zero means unlimited
+	EvictionPolicy EvictionPolicy
+	Log            LoggerOptions     // how the transaction log is written when logging is enabled
+	Snapshot       SnapshotOptions   // how snapshots are taken when logging is enabled
+	RecoverTo      RecoveryTarget    // the point the store is recovered to at startup, zero for the latest
+	Encryption     EncryptionOptions // where the keys that encrypt the log and snapshots are loaded from
+	Indexes        []IndexDefinition // the secondary indexes on fields of JSON values
+}
+
+// StartGRPCServer starts a gRPC server on port 7878.
+// If cfg.EnableLogging is true, it initializes logging to the specified file and takes snapshots as configured by cfg.Snapshot.
+func StartGRPCServer(cfg Config) error {
+	log.Printf("Starting server on port 7878...")
+
+	// initialize the keyvalue store and logging
+	server := NewGRPCServer()
+	server.kv.SetMemoryLimit(cfg.MaxMemory, cfg.EvictionPolicy)
+	for _, def := range cfg.Indexes {
+		if err := server.kv.CreateIndex(def); err != nil {
+			return fmt.Errorf("failed to create index %s: %w", def.Name, err)
+		}
+	}
+	if cfg.EnableLogging {
+		keys, keysErr := cfg.Encryption.LoadKeyring()
+		if keysErr != nil {
+			return fmt.Errorf("failed to load encryption keys: %w", keysErr)
+		}
+		cfg.Log.Keys = keys
+
+		if err := server.kv.InitLoggingTo(LogFile, cfg.Log, cfg.Snapshot, cfg.RecoverTo); err != nil {
+			return fmt.Errorf("failed to initialize logging: %w", err)
+		}
+	}
+	server.kv.StartExpiryReaper(expiryReapInterval)
+
+	// create a new gRPC server with or without tls
+	s, serverFactoryErr := grpcServerFactory(cfg.EnableSecurity)
+	if serverFactoryErr != nil {
+		return fmt.Errorf("failed to create server: %w", serverFactoryErr)
+	}
+
+	// register the KeyValueService server
+	proto.RegisterKeyValueServiceServer(s, server)
+	proto.RegisterAdminServiceServer(s, NewAdminServer(server.kv, cfg.Encryption))
+
+	// setup listener
+	lis, listenErr := net.Listen("tcp", "0.0.0.0:7878")
+	if listenErr != nil {
+		return fmt.Errorf("failed to listen: %w", listenErr)
+	}
+
+	// serve the gRPC server
+	serveErr := s.Serve(lis)
+	if serveErr != nil {
+		return fmt.Errorf("failed to serve: %w", serveErr)
+	}
+	return nil
+}
+
+// grpcServerFactory creates a new gRPC server with or without security enabled.
+func grpcServerFactory(enableSecurity bool) (*grpc.Server, error) {
+	if enableSecurity {
+		// load the server's certificate and private key
+		cert, certPairErr := tls.LoadX509KeyPair("certs/server.crt", "certs/server.key")
+		if certPairErr != nil {
+			return nil, fmt.Errorf("failed to load X509 key pair: %w", certPairErr)
+		}
+
+		// setup and load the CA's certificate
+		ca := x509.NewCertPool()
+		caFilePath := "certs/ca.crt"
+		caBytes, caBytesErr := os.ReadFile(caFilePath)
+		if caBytesErr != nil {
+			return nil, fmt.Errorf("failed to read CA certificate: %w", caBytesErr)
+		}
+		if ok := ca.AppendCertsFromPEM(caBytes); !ok {
+			return nil, fmt.Errorf("failed to append CA certificate")
+		}
+
+		// create a new TLS configuration with the server's certificate and the CA's certificate
+		tlsConfig := &tls.Config{
+			ClientAuth:   tls.RequireAndVerifyClientCert,
+			Certificates: []tls.Certificate{cert},
+			ClientCAs:    ca,
+		}
+
+		// create a new gRPC server with the TLS configuration
+		return grpc.NewServer(grpc.Creds(credentials.NewTLS(tlsConfig))), nil
+	}
+
+	return grpc.NewServer(), nil
+}
@@ -1,234 +1,578 @@
-package keyvaluestore
-
-import (
-	"encoding/json"
-	"fmt"
-	"log"
-	"maps"
-	"os"
-	"sync"
-	"time"
-)
-
-// KeyValueStore represents the key-value store.
-type KeyValueStore struct {
-	data             map[string][]byte
-	mu               sync.RWMutex
-	logger           *Logger
-	snapshotInterval time.Duration
-}
-
-func (kv *KeyValueStore) InitLogging(logFile string, snapshotInterval time.Duration) error {
-	// Check if the log file exists, create it if it doesn't
-	if _, err := os.Stat(logFile); os.IsNotExist(err) {
-		file, createErr := os.Create(logFile)
-		if createErr != nil {
-			return fmt.Errorf("failed to create log file: %w", createErr)
-		}
-		file.Close()
-	}
-
-	logger, loggerErr := NewLogger(logFile)
-	if loggerErr != nil {
-		return loggerErr
-	}
-
-	kv.logger = logger
-	kv.snapshotInterval = snapshotInterval
-
-	// Load the latest snapshot
-	if snapshotErr := kv.LoadLatestSnapshot(); snapshotErr != nil {
-		return fmt.Errorf("failed to load latest snapshot: %w", snapshotErr)
-	}
-
-	// Read and process log entries
-	entries, readLogsErr := logger.ReadLogs()
-	if readLogsErr != nil {
-		return fmt.Errorf("failed to read log entries: %w", readLogsErr)
-	}
-
-	kv.ProcessLogEntries(entries)
-
-	// Start the snapshot scheduler
-	go kv.snapshotScheduler()
-	return nil
-}
-
-// NewKeyValueStore creates a new instance of KeyValueStore.
-func NewKeyValueStore() *KeyValueStore {
-	kv := &KeyValueStore{
-		data:             make(map[string][]byte),
-		logger:           nil,
-		snapshotInterval: 1 * time.Hour,
-	}
-
-	return kv
-}
-
-type KeyValue struct {
-	Key   string          `json:"key"`
-	Value json.RawMessage `json:"value"`
-}
-
-func NewKeyValue(key string, value json.RawMessage) *KeyValue {
-	kv := KeyValue{
-		Key:   key,
-		Value: value,
-	}
-
-	return &kv
-}
-
-// Set adds or updates a key-value pair in the store.
-func (kv *KeyValueStore) Set(key string, value json.RawMessage) {
-	kv.mu.Lock()
-	defer kv.mu.Unlock()
-
-	// if the logger is enabled, write a log entry before value is created/updated
-	kv.quickLog("SET", key, string(value))
-
-	// Set value in store
-	kv.data[key] = value
-	log.Printf("Set \"%s\" to \"%s\"", key, value)
-}
-
-// Get retrieves the value associated with a key from the store.
-func (kv *KeyValueStore) Get(key string) (json.RawMessage, bool) {
-	kv.mu.RLock()
-	defer kv.mu.RUnlock()
-
-	val, ok := kv.data[key]
-	log.Printf("Get \"%s\" from kvs", key)
-
-	// Write log entry
-	kv.quickLog("GET", key, string(val))
-
-	return val, ok
-}
-
-// GetAll retries all key-values pairs from the store.
-func (kv *KeyValueStore) GetAll() map[string][]byte {
-	kv.mu.RLock()
-	defer kv.mu.RUnlock()
-
-	// Log operation, even though we're not logging the values themselves
-	kv.quickLog("GETALL", "", "")
-	log.Print("Get all key-value pairs from kvs")
-
-	return maps.Clone(kv.data)
-}
-
-// GetKeys returns all keys from the store.
-func (kv *KeyValueStore) GetKeys() []string {
-	kv.mu.RLock()
-	defer kv.mu.RUnlock()
-
-	// Log operation, even though we're not logging the keys themselves
-	kv.quickLog("GETKEYS", "", "")
-	log.Print("Get all keys from kvs")
-
-	// Copy keys to a new slice
-	keys := make([]string, 0, len(kv.data))
-	for k := range kv.data {
-		keys = append(keys, k)
-	}
-
-	return keys
-}
-
-// GetValues returns all values from the store.
-func (kv *KeyValueStore) GetValues() []json.RawMessage {
-	kv.mu.RLock()
-	defer kv.mu.RUnlock()
-
-	// Log operation, even though we're not logging the values themselves
-	kv.quickLog("GETVALUES", "", "")
-	log.Print("Get all values from kvs")
-
-	// Copy values to a new slice
-	values := make([]json.RawMessage, 0, len(kv.data))
-	for _, v := range kv.data {
-		values = append(values, v)
-	}
-
-	return values
-}
-
-// Deletes all key/value pairs from the store and clears the transaction logs.
-func (kv *KeyValueStore) DeleteALL() error {
-	kv.mu.Lock()
-	defer kv.mu.Unlock()
-
-	// Log the operation
-	kv.quickLog("DELETEALL", "", "")
-
-	// Clear the in-memory data
-	kv.data = make(map[string][]byte)
-	log.Print("Delete all key-value pairs from kvs")
-
-	return nil
-}
-
-// Deletes a specific key value pair from the store.
-func (kv *KeyValueStore) Delete(key string) ([]byte, bool) {
-	kv.mu.Lock()
-	defer kv.mu.Unlock()
-
-	// get value to be deleted
-	deletedVal, ok := kv.data[key]
-
-	// log entry
-	kv.quickLog("DELETE", key, string(deletedVal))
-
-	// delete key from store
-	delete(kv.data, key)
-	log.Printf("Deleted \"%s\" from kvs", key)
-
-	return deletedVal, ok
-}
-
-// ProcessLogEntries processes a list of log entries and updates the key-value store accordingly.
-func (kv *KeyValueStore) ProcessLogEntries(entries []LogEntry) {
-	kv.mu.Lock()
-	defer kv.mu.Unlock()
-
-	// Process each log entry depending on the operation
-	for _, entry := range entries {
-		switch entry.Operation {
-		case "SET": // Add or update the key:value pair in the in-memory data
-			kv.data[entry.Key] = []byte(entry.Value)
-		case "DELETE": // Delete the key:value pair from the in-memory data
-			delete(kv.data, entry.Key)
-		case "DELETEALL": // Clear all the in-memory data
-			kv.data = make(map[string][]byte)
-		}
-	}
-}
-
-// snapshotScheduler runs periodically to take snapshots of the key-value store.
-// It uses a ticker to trigger snapshots at the interval specified by kv.snapshotInterval.
-// If a snapshot fails, it logs the error but continues running.
-func (kv *KeyValueStore) snapshotScheduler() {
-	ticker := time.NewTicker(kv.snapshotInterval)
-	defer ticker.Stop()
-
-	for range ticker.C {
-		if err := kv.TakeSnapshot(); err != nil {
-			log.Printf("Failed to take snapshot: %v", err)
-		}
-
-		log.Printf("Snapshot taken at %v", time.Now())
-	}
-}
-
-// Quick log entry utility function.
-func (kv *KeyValueStore) quickLog(operation string, key string, value string) {
-	if kv.logger != nil {
-		go kv.logger.WriteLog(LogEntry{
-			Timestamp: time.Now(),
-			Operation: operation,
-			Key:       key,
-			Value:     value,
-		})
-	}
-}
+package keyvaluestore
+
+import (
+	"fmt"
+	"log"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/google/btree"
+)
+
+// KeyValueStore represents the key-value store.
+type KeyValueStore struct {
+	data           map[string]*entry
+	index          *btree.BTreeG[indexItem] // the keys of data in lexicographic order
+	volatile       map[string]struct{}
+	fieldIndexes   map[string]*fieldIndex // secondary indexes on fields of JSON values, by name
+	mu             sync.RWMutex
+	logger         *Logger
+	snapshotOpts   SnapshotOptions
+	snapshotMu     sync.Mutex // serializes the creation and removal of snapshot files
+	maxMemory      int64
+	usedMemory     int64
+	evictionPolicy EvictionPolicy
+	revision       uint64 // incremented by every mutation
+	watch          watchHub
+}
+
+// entry is a single value held by the store along with its metadata.
+type entry struct {
+	value       []byte
+	contentType string       // the declared media type of value, empty if it is opaque bytes
+	version     uint64       // store revision at which the value was last written
+	expiresAt   time.Time    // zero if the key never expires
+	lastAccess  atomic.Int64 // unix nanoseconds, updated under the read lock
+	hits        atomic.Uint64
+}
+
+// expired reports whether the entry has a TTL that has elapsed at now.
+func (e *entry) expired(now time.Time) bool {
+	return !e.expiresAt.IsZero() && !now.Before(e.expiresAt)
+}
+
+// InitLogging restores the store from the latest snapshot and the transaction log in
+// logFile, and logs every later change to it as configured by opts. Snapshots are
+// taken and written as configured by snapshots.
+func (kv *KeyValueStore) InitLogging(logFile string, opts LoggerOptions, snapshots SnapshotOptions) error {
+	return kv.InitLoggingTo(logFile, opts, snapshots, RecoveryTarget{})
+}
+
+// NewKeyValueStore creates a new instance of KeyValueStore.
+func NewKeyValueStore() *KeyValueStore {
+	kv := &KeyValueStore{
+		data:           make(map[string]*entry),
+		index:          newIndex(),
+		volatile:       make(map[string]struct{}),
+		fieldIndexes:   make(map[string]*fieldIndex),
+		logger:         nil,
+		snapshotOpts:   SnapshotOptions{}.withDefaults(),
+		evictionPolicy: NoEviction,
+	}
+
+	return kv
+}
+
+type KeyValue struct {
+	Key         string `json:"key"`
+	Value       []byte `json:"value"`
+	ContentType string `json:"content_type,omitempty"` // the media type of Value, empty for opaque bytes
+}
+
+func NewKeyValue(key string, value []byte) *KeyValue {
+	kv := KeyValue{
+		Key:   key,
+		Value: value,
+	}
+
+	return &kv
+}
+
+// SetOptions holds the optional settings and preconditions of SetWithOptions.
+type SetOptions struct {
+	// TTL expires the key after the given duration. Zero or less never expires it.
+	TTL time.Duration
+	// IfVersion only applies the write if the key is currently at this version. Zero disables the check.
+	IfVersion uint64
+	// IfAbsent only applies the write if the key does not exist.
+	IfAbsent bool
+	// ContentType declares the media type of the value, such as application/json.
+	// Values without one are stored as opaque bytes. A value declared as JSON must
+	// be valid JSON.
+	ContentType string
+}
+
+// Set adds or updates a key-value pair in the store.
+// It returns ErrOutOfMemory if the value does not fit within the memory limit.
+func (kv *KeyValueStore) Set(key string, value []byte) error {
+	return kv.SetWithTTL(key, value, 0)
+}
+
+// SetWithTTL adds or updates a key-value pair in the store that expires after ttl.
+// A ttl of zero or less stores the value without an expiration.
+func (kv *KeyValueStore) SetWithTTL(key string, value []byte, ttl time.Duration) error {
+	_, err := kv.SetWithOptions(key, value, SetOptions{TTL: ttl})
+	return err
+}
+
+// SetWithOptions adds or updates a key-value pair in the store and returns its new version.
+// It returns ErrVersionMismatch or ErrKeyExists if a precondition in opts does not hold.
+func (kv *KeyValueStore) SetWithOptions(key string, value []byte, opts SetOptions) (uint64, error) {
+	if err := validateValue("", value, opts.ContentType); err != nil {
+		return 0, &KeyError{Key: key, Err: err}
+	}
+
+	kv.mu.Lock()
+	defer kv.mu.Unlock()
+
+	now := time.Now()
+	if err := kv.checkPreconditions(key, opts, now); err != nil {
+		return 0, err
+	}
+
+	if err := kv.checkLogBackpressure(); err != nil {
+		return 0, &KeyError{Key: key, Err: err}
+	}
+
+	// Evict other keys if needed before anything is logged
+	expiresAt := expiryFor(opts.TTL, now)
+	e := newEntry(value, opts.ContentType, expiresAt, 0)
+	if err := kv.makeRoom(func() int64 { return kv.sizeDelta(key, e) }); err != nil {
+		return 0, &KeyError{Key: key, Err: err}
+	}
+
+	// if the logger is enabled, write a log entry before value is created/updated
+	version, err := kv.recordMutation(LogEntry{
+		Timestamp:   now,
+		Operation:   "SET",
+		Key:         key,
+		Value:       value,
+		ContentType: opts.ContentType,
+		ExpiresAt:   expiresAt,
+	})
+	if err != nil {
+		return 0, &KeyError{Key: key, Err: err}
+	}
+	e.version = version
+
+	// Set value in store
+	kv.put(key, e)
+	log.Printf("Set \"%s\" to %s at version %d", key, formatValue(value, opts.ContentType), e.version)
+
+	return e.version, nil
+}
+
+// CompareAndSwap sets key to value only if the key is currently at expectedVersion,
+// and returns the new version. An expectedVersion of zero requires the key to be absent.
+func (kv *KeyValueStore) CompareAndSwap(key string, expectedVersion uint64, value []byte, ttl time.Duration) (uint64, error) {
+	return kv.compareAndSwap(key, expectedVersion, value, SetOptions{TTL: ttl})
+}
+
+// compareAndSwap is CompareAndSwap with the other options of the write in opts.
+func (kv *KeyValueStore) compareAndSwap(key string, expectedVersion uint64, value []byte, opts SetOptions) (uint64, error) {
+	opts.IfVersion = expectedVersion
+	opts.IfAbsent = expectedVersion == 0
+
+	return kv.SetWithOptions(key, value, opts)
+}
+
+// update atomically replaces the entry of key with the one returned by fn, which
+// is passed the live entry of key or nil if there is none. The new value is logged
+// as a SET, so that replaying the log doesn't depend on the old one. If fn returns
+// the current entry nothing is written, and if it returns nil the key is deleted.
+func (kv *KeyValueStore) update(key string, fn func(current *entry, now time.Time) (*entry, error)) (*entry, error) {
+	kv.mu.Lock()
+	defer kv.mu.Unlock()
+
+	now := time.Now()
+	current, _ := kv.live(key, now)
+	e, err := fn(current, now)
+	if err != nil {
+		return nil, &KeyError{Key: key, Err: err}
+	}
+	if e == current {
+		return e, nil
+	}
+
+	if err = kv.checkLogBackpressure(); err != nil {
+		return nil, &KeyError{Key: key, Err: err}
+	}
+
+	if e == nil {
+		deletion := LogEntry{Timestamp: now, Operation: "DELETE", Key: key, Value: current.value, ContentType: current.contentType}
+		if _, err = kv.recordMutation(deletion); err != nil {
+			return nil, &KeyError{Key: key, Err: err}
+		}
+
+		kv.remove(key)
+		log.Printf("Deleted \"%s\" from kvs", key)
+
+		return nil, nil
+	}
+
+	if err = kv.makeRoom(func() int64 { return kv.sizeDelta(key, e) }); err != nil {
+		return nil, &KeyError{Key: key, Err: err}
+	}
+
+	version, err := kv.recordMutation(LogEntry{
+		Timestamp:   now,
+		Operation:   "SET",
+		Key:         key,
+		Value:       e.value,
+		ContentType: e.contentType,
+		ExpiresAt:   e.expiresAt,
+	})
+	if err != nil {
+		return nil, &KeyError{Key: key, Err: err}
+	}
+	e.version = version
+
+	kv.put(key, e)
+	log.Printf("Updated \"%s\" to %s at version %d", key, formatValue(e.value, e.contentType), e.version)
+
+	return e, nil
+}
+
+// expiryFor returns when a key written at now with ttl expires, or the zero time if ttl is not positive.
+func expiryFor(ttl time.Duration, now time.Time) time.Time {
+	if ttl <= 0 {
+		return time.Time{}
+	}
+
+	return now.Add(ttl)
+}
+
+// live returns the entry stored under key unless it is missing or expired at now.
+// The caller must hold kv.mu.
+func (kv *KeyValueStore) live(key string, now time.Time) (*entry, bool) {
+	e, ok := kv.data[key]
+	if !ok || e.expired(now) {
+		return nil, false
+	}
+
+	return e, true
+}
+
+// checkPreconditions verifies the conditions in opts against the current state of key.
+// The caller must hold kv.mu.
+func (kv *KeyValueStore) checkPreconditions(key string, opts SetOptions, now time.Time) error {
+	var current uint64
+	if e, ok := kv.live(key, now); ok {
+		current = e.version
+	}
+
+	if opts.IfAbsent && current != 0 {
+		return &KeyError{Key: key, Err: fmt.Errorf("%w at version %d", ErrKeyExists, current)}
+	}
+
+	if opts.IfVersion != 0 && opts.IfVersion != current {
+		return &KeyError{Key: key, Err: fmt.Errorf("%w: expected version %d, found %d", ErrVersionMismatch, opts.IfVersion, current)}
+	}
+
+	return nil
+}
+
+// Get retrieves the value associated with a key from the store.
+// Keys whose TTL has elapsed are reported as missing and removed from the store.
+func (kv *KeyValueStore) Get(key string) ([]byte, bool) {
+	val, _, ok := kv.GetWithVersion(key)
+	return val, ok
+}
+
+// GetWithVersion retrieves the value associated with a key along with its current version.
+func (kv *KeyValueStore) GetWithVersion(key string) ([]byte, uint64, bool) {
+	item, ok := kv.GetItem(key)
+	return item.Value, item.Version, ok
+}
+
+// GetItem retrieves the value associated with a key along with its current version
+// and content type.
+func (kv *KeyValueStore) GetItem(key string) (ScanItem, bool) {
+	e, ok, expired := kv.get(key)
+	if expired {
+		// Lazily remove the key now that we know its TTL has elapsed
+		kv.expire(key)
+	}
+
+	if !ok {
+		return ScanItem{}, false
+	}
+
+	return e.item(key), true
+}
+
+// get looks up key under the read lock. The last return value reports
+// whether the key exists but has expired.
+func (kv *KeyValueStore) get(key string) (*entry, bool, bool) {
+	kv.mu.RLock()
+	defer kv.mu.RUnlock()
+
+	e, ok := kv.data[key]
+	log.Printf("Get \"%s\" from kvs", key)
+
+	if ok && e.expired(time.Now()) {
+		return nil, false, true
+	}
+
+	if ok {
+		e.touch(time.Now())
+	}
+
+	return e, ok, false
+}
+
+// GetAll retries all key-values pairs from the store.
+func (kv *KeyValueStore) GetAll() map[string][]byte {
+	all := kv.GetAllItems()
+	items := make(map[string][]byte, len(all))
+	for _, item := range all {
+		items[item.Key] = item.Value
+	}
+
+	return items
+}
+
+// GetAllItems retrieves every key in the store along with its value, version and
+// content type, in no particular order.
+func (kv *KeyValueStore) GetAllItems() []ScanItem {
+	kv.mu.RLock()
+	defer kv.mu.RUnlock()
+
+	log.Print("Get all key-value pairs from kvs")
+
+	now := time.Now()
+	items := make([]ScanItem, 0, len(kv.data))
+	for k, e := range kv.data {
+		if !e.expired(now) {
+			items = append(items, e.item(k))
+		}
+	}
+
+	return items
+}
+
+// GetKeys returns all keys from the store.
+func (kv *KeyValueStore) GetKeys() []string {
+	kv.mu.RLock()
+	defer kv.mu.RUnlock()
+
+	log.Print("Get all keys from kvs")
+
+	// Copy keys to a new slice
+	now := time.Now()
+	keys := make([]string, 0, len(kv.data))
+	for k, e := range kv.data {
+		if !e.expired(now) {
+			keys = append(keys, k)
+		}
+	}
+
+	return keys
+}
+
+// GetValues returns all values from the store.
+func (kv *KeyValueStore) GetValues() [][]byte {
+	kv.mu.RLock()
+	defer kv.mu.RUnlock()
+
+	log.Print("Get all values from kvs")
+
+	// Copy values to a new slice
+	now := time.Now()
+	values := make([][]byte, 0, len(kv.data))
+	for _, e := range kv.data {
+		if !e.expired(now) {
+			values = append(values, e.value)
+		}
+	}
+
+	return values
+}
+
+// Deletes all key/value pairs from the store and clears the transaction logs.
+func (kv *KeyValueStore) DeleteALL() error {
+	kv.mu.Lock()
+	defer kv.mu.Unlock()
+
+	if err := kv.checkLogBackpressure(); err != nil {
+		return err
+	}
+
+	// Log the operation
+	if _, err := kv.recordMutation(LogEntry{Timestamp: time.Now(), Operation: "DELETEALL"}); err != nil {
+		return err
+	}
+
+	// Clear the in-memory data
+	kv.reset()
+	log.Print("Delete all key-value pairs from kvs")
+
+	return nil
+}
+
+// Delete deletes a specific key value pair from the store and returns the deleted value,
+// reporting whether the key existed. The key is only deleted once the deletion has been
+// written to the transaction log.
+func (kv *KeyValueStore) Delete(key string) ([]byte, bool, error) {
+	kv.mu.Lock()
+	defer kv.mu.Unlock()
+
+	// get value to be deleted, ignoring keys that have already expired
+	now := time.Now()
+	e, ok := kv.data[key]
+	if !ok {
+		return nil, false, nil
+	}
+	if e.expired(now) {
+		kv.expireLocked(key, now)
+		return nil, false, nil
+	}
+
+	if err := kv.checkLogBackpressure(); err != nil {
+		return nil, false, &KeyError{Key: key, Err: err}
+	}
+
+	// log entry before the key is deleted
+	deletion := LogEntry{Timestamp: now, Operation: "DELETE", Key: key, Value: e.value, ContentType: e.contentType}
+	if _, err := kv.recordMutation(deletion); err != nil {
+		return nil, false, &KeyError{Key: key, Err: err}
+	}
+
+	// delete key from store
+	kv.remove(key)
+	log.Printf("Deleted \"%s\" from kvs", key)
+
+	return e.value, true, nil
+}
+
+// ProcessLogEntries processes a list of log entries and updates the key-value store accordingly.
+func (kv *KeyValueStore) ProcessLogEntries(entries []LogEntry) {
+	kv.replay(entries, RecoveryTarget{})
+}
+
+// applyLogEntry replays a single log entry against the in-memory data.
+// The caller must hold kv.mu for writing.
+func (kv *KeyValueStore) applyLogEntry(entry LogEntry, now time.Time) {
+	switch entry.Operation {
+	case "SET": // Add or update the key:value pair in the in-memory data
+		if !entry.ExpiresAt.IsZero() && !now.Before(entry.ExpiresAt) {
+			kv.remove(entry.Key) // already expired, don't bring it back
+			return
+		}
+		kv.put(entry.Key, newEntry(entry.Value, entry.ContentType, entry.ExpiresAt, entry.Revision))
+	case "DELETE", "EXPIRE", "EVICT": // Delete the key:value pair from the in-memory data
+		kv.remove(entry.Key)
+	case "DELETEALL": // Clear all the in-memory data
+		kv.reset()
+	case "TXN": // Apply every write of a transaction at the transaction's revision
+		for _, op := range entry.Batch {
+			op.Revision = entry.Revision
+			kv.applyLogEntry(op, now)
+		}
+	}
+}
+
+// newEntry creates an entry holding value of contentType at version that expires at expiresAt.
+func newEntry(value []byte, contentType string, expiresAt time.Time, version uint64) *entry {
+	e := &entry{value: value, contentType: contentType, version: version, expiresAt: expiresAt}
+	e.touch(time.Now())
+
+	return e
+}
+
+// item returns the entry stored under key as a ScanItem.
+func (e *entry) item(key string) ScanItem {
+	return ScanItem{Key: key, Value: e.value, Version: e.version, ContentType: e.contentType}
+}
+
+// put stores e under key and keeps the key and field indexes and memory usage up to date.
+// The caller must hold kv.mu for writing.
+func (kv *KeyValueStore) put(key string, e *entry) {
+	if old, ok := kv.data[key]; ok {
+		kv.usedMemory -= entrySize(key, old)
+	}
+
+	kv.data[key] = e
+	kv.index.ReplaceOrInsert(indexItem{key: key, e: e})
+	kv.usedMemory += entrySize(key, e)
+	if e.expiresAt.IsZero() {
+		delete(kv.volatile, key)
+	} else {
+		kv.volatile[key] = struct{}{}
+	}
+	for _, ix := range kv.fieldIndexes {
+		ix.put(key, e)
+	}
+}
+
+// remove deletes key from the store and the key and field indexes.
+// The caller must hold kv.mu for writing.
+func (kv *KeyValueStore) remove(key string) {
+	if old, ok := kv.data[key]; ok {
+		kv.usedMemory -= entrySize(key, old)
+	}
+
+	delete(kv.data, key)
+	kv.index.Delete(indexItem{key: key})
+	delete(kv.volatile, key)
+	for _, ix := range kv.fieldIndexes {
+		ix.remove(key)
+	}
+}
+
+// reset removes every key from the store.
+// The caller must hold kv.mu for writing.
+func (kv *KeyValueStore) reset() {
+	kv.data = make(map[string]*entry)
+	kv.index = newIndex()
+	kv.volatile = make(map[string]struct{})
+	kv.usedMemory = 0
+	for _, ix := range kv.fieldIndexes {
+		ix.clear()
+	}
+}
+
+// snapshotScheduler runs periodically to take snapshots of the key-value store.
+// It uses a ticker to trigger snapshots at the interval configured in kv.snapshotOpts.
+// If a snapshot fails, it logs the error but continues running.
+func (kv *KeyValueStore) snapshotScheduler() {
+	ticker := time.NewTicker(kv.snapshotOpts.Interval)
+	defer ticker.Stop()
+
+	for range ticker.C {
+		info, err := kv.TakeSnapshot()
+		if err != nil {
+			log.Printf("Failed to take snapshot: %v", err)
+			continue
+		}
+
+		log.Printf("Snapshot %s taken with %d keys", info.Name, info.Keys)
+	}
+}
+
+// recordMutation assigns the next store revision to entry, writes it to the
+// transaction log, notifies watchers and returns the revision. If the log can't
+// be written the revision is not used and the caller must not apply the change.
+// The caller must hold kv.mu for writing, which keeps the log in mutation order.
+func (kv *KeyValueStore) recordMutation(entry LogEntry) (uint64, error) {
+	entry.Revision = kv.revision + 1
+	if err := kv.writeLog(entry); err != nil {
+		return 0, fmt.Errorf("failed to write transaction log: %w", err)
+	}
+
+	kv.revision = entry.Revision
+	kv.watch.publish(eventsFor(entry))
+
+	return kv.revision, nil
+}
+
+// checkLogBackpressure returns ErrLogBackpressure if the transaction log can't keep
+// up with writes. Writes that can fail check it first, so that a slow disk pushes
+// back on clients instead of stalling the store while it holds kv.mu.
+func (kv *KeyValueStore) checkLogBackpressure() error {
+	if kv.logger != nil && kv.logger.Overloaded() {
+		return ErrLogBackpressure
+	}
+
+	return nil
+}
+
+// writeLog writes entry to the transaction log if the logger is enabled.
+func (kv *KeyValueStore) writeLog(entry LogEntry) error {
+	if kv.logger == nil {
+		return nil
+	}
+
+	return kv.logger.WriteLog(entry)
+}
@@ -1,144 +1,2123 @@
-package keyvaluestore_test
-
-import (
-	"encoding/json"
-	"log"
-	"testing"
-
-	herd "github.com/defoeam/herd/internal"
-)
-
-func TestGetSet(t *testing.T) {
-	// Create a new KeyValueStore instance
-	kv := herd.NewKeyValueStore()
-
-	t.Run("Set and Get", func(t *testing.T) {
-		key := "test_key"
-		value := json.RawMessage(`{"name":"John","age":30}`)
-
-		kv.Set(key, value)
-
-		retrievedValue, ok := kv.Get(key)
-		if !ok {
-			t.Errorf("Failed to retrieve value for key %s", key)
-		}
-
-		if string(retrievedValue) != string(value) {
-			t.Errorf("Retrieved value %s does not match set value %s", retrievedValue, value)
-		}
-	})
-
-	t.Run("GetAll", func(t *testing.T) {
-		kv.Set("key1", json.RawMessage(`"value1"`))
-		kv.Set("key2", json.RawMessage(`"value2"`))
-
-		allItems := kv.GetAll()
-
-		if len(allItems) != 3 { // Including the previous "test_key"
-			t.Errorf("Expected 3 items, got %d", len(allItems))
-		}
-
-		if string(allItems["key1"]) != `"value1"` {
-			t.Errorf("Unexpected value for key1: %s", allItems["key1"])
-		}
-
-		if string(allItems["key2"]) != `"value2"` {
-			t.Errorf("Unexpected value for key2: %s", allItems["key2"])
-		}
-	})
-}
-
-func TestGetKeysValues(t *testing.T) {
-	kv := herd.NewKeyValueStore()
-
-	t.Run("GetKeys", func(t *testing.T) {
-		// clear all items to prep for test
-		err := kv.DeleteALL()
-		if err != nil {
-			log.Printf("Failed to clear all items: %v", err)
-		}
-
-		// add test key/value pairs
-		kv.Set("key1", json.RawMessage(`"value1"`))
-		kv.Set("key2", json.RawMessage(`"value2"`))
-
-		keys := kv.GetKeys()
-
-		if len(keys) != 2 {
-			t.Errorf("Expected 2 keys, got %d", len(keys))
-		}
-
-		expectedKeys := map[string]bool{"key1": true, "key2": true}
-		for _, key := range keys {
-			if !expectedKeys[key] {
-				t.Errorf("Unexpected key: %s", key)
-			}
-		}
-	})
-
-	t.Run("GetValues", func(t *testing.T) {
-		// clear all items to prep for test
-		err := kv.DeleteALL()
-		if err != nil {
-			log.Printf("Failed to clear all items: %v", err)
-		}
-
-		// add test key/value pairs
-		kv.Set("key1", json.RawMessage(`"value1"`))
-		kv.Set("key2", json.RawMessage(`"value2"`))
-
-		values := kv.GetValues()
-
-		if len(values) != 2 {
-			t.Errorf("Expected 2 values, got %d", len(values))
-		}
-
-		expectedValues := map[string]bool{`"value1"`: true, `"value2"`: true}
-		for _, value := range values {
-			if !expectedValues[string(value)] {
-				t.Errorf("Unexpected value: %s", string(value))
-			}
-		}
-	})
-}
-
-func TestClear(t *testing.T) {
-	// Create a new KeyValueStore instance
-	kv := herd.NewKeyValueStore()
-
-	t.Run("Clear", func(t *testing.T) {
-		key := "key_to_clear"
-		value := json.RawMessage(`"clear_me"`)
-
-		kv.Set(key, value)
-
-		clearedValue, ok := kv.Delete(key)
-		if !ok {
-			t.Errorf("Failed to clear key %s", key)
-		}
-
-		if string(clearedValue) != string(value) {
-			t.Errorf("Cleared value %s does not match set value %s", clearedValue, value)
-		}
-
-		_, exists := kv.Get(key)
-		if exists {
-			t.Errorf("Key %s still exists after clearing", key)
-		}
-	})
-
-	t.Run("ClearAll", func(t *testing.T) {
-		kv.Set("key1", json.RawMessage(`"value1"`))
-		kv.Set("key2", json.RawMessage(`"value2"`))
-
-		if err := kv.DeleteALL(); err != nil {
-			// Handle the error appropriately
-			log.Printf("Failed to clear all items: %v", err)
-		}
-
-		allItems := kv.GetAll()
-		if len(allItems) != 0 {
-			t.Errorf("Expected 0 items after ClearAll, got %d", len(allItems))
-		}
-	})
-}
+package keyvaluestore_test
+
+import (
+	"bytes"
+	"context"
+	"encoding/base64"
+	"encoding/binary"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log"
+	"math"
+	"os"
+	"path/filepath"
+	"slices"
+	"strconv"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/defoeam/herd/api/proto"
+	herd "github.com/defoeam/herd/internal"
+	"google.golang.org/grpc"
+)
+
+func TestGetSet(t *testing.T) {
+	// Create a new KeyValueStore instance
+	kv := herd.NewKeyValueStore()
+
+	t.Run("Set and Get", func(t *testing.T) {
+		key := "test_key"
+		value := json.RawMessage(`{"name":"John","age":30}`)
+
+		kv.Set(key, value)
+
+		retrievedValue, ok := kv.Get(key)
+		if !ok {
+			t.Errorf("Failed to retrieve value for key %s", key)
+		}
+
+		if string(retrievedValue) != string(value) {
+			t.Errorf("Retrieved value %s does not match set value %s", retrievedValue, value)
+		}
+	})
+
+	t.Run("GetAll", func(t *testing.T) {
+		kv.Set("key1", json.RawMessage(`"value1"`))
+		kv.Set("key2", json.RawMessage(`"value2"`))
+
+		allItems := kv.GetAll()
+
+		if len(allItems) != 3 { // Including the previous "test_key"
+			t.Errorf("Expected 3 items, got %d", len(allItems))
+		}
+
+		if string(allItems["key1"]) != `"value1"` {
+			t.Errorf("Unexpected value for key1: %s", allItems["key1"])
+		}
+
+		if string(allItems["key2"]) != `"value2"` {
+			t.Errorf("Unexpected value for key2: %s", allItems["key2"])
+		}
+	})
+}
+
+func TestGetKeysValues(t *testing.T) {
+	kv := herd.NewKeyValueStore()
+
+	t.Run("GetKeys", func(t *testing.T) {
+		// clear all items to prep for test
+		err := kv.DeleteALL()
+		if err != nil {
+			log.Printf("Failed to clear all items: %v", err)
+		}
+
+		// add test key/value pairs
+		kv.Set("key1", json.RawMessage(`"value1"`))
+		kv.Set("key2", json.RawMessage(`"value2"`))
+
+		keys := kv.GetKeys()
+
+		if len(keys) != 2 {
+			t.Errorf("Expected 2 keys, got %d", len(keys))
+		}
+
+		expectedKeys := map[string]bool{"key1": true, "key2": true}
+		for _, key := range keys {
+			if !expectedKeys[key] {
+				t.Errorf("Unexpected key: %s", key)
+			}
+		}
+	})
+
+	t.Run("GetValues", func(t *testing.T) {
+		// clear all items to prep for test
+		err := kv.DeleteALL()
+		if err != nil {
+			log.Printf("Failed to clear all items: %v", err)
+		}
+
+		// add test key/value pairs
+		kv.Set("key1", json.RawMessage(`"value1"`))
+		kv.Set("key2", json.RawMessage(`"value2"`))
+
+		values := kv.GetValues()
+
+		if len(values) != 2 {
+			t.Errorf("Expected 2 values, got %d", len(values))
+		}
+
+		expectedValues := map[string]bool{`"value1"`: true, `"value2"`: true}
+		for _, value := range values {
+			if !expectedValues[string(value)] {
+				t.Errorf("Unexpected value: %s", string(value))
+			}
+		}
+	})
+}
+
+func TestClear(t *testing.T) {
+	// Create a new KeyValueStore instance
+	kv := herd.NewKeyValueStore()
+
+	t.Run("Clear", func(t *testing.T) {
+		key := "key_to_clear"
+		value := json.RawMessage(`"clear_me"`)
+
+		kv.Set(key, value)
+
+		clearedValue, ok, err := kv.Delete(key)
+		if err != nil || !ok {
+			t.Errorf("Failed to clear key %s", key)
+		}
+
+		if string(clearedValue) != string(value) {
+			t.Errorf("Cleared value %s does not match set value %s", clearedValue, value)
+		}
+
+		_, exists := kv.Get(key)
+		if exists {
+			t.Errorf("Key %s still exists after clearing", key)
+		}
+	})
+
+	t.Run("ClearAll", func(t *testing.T) {
+		kv.Set("key1", json.RawMessage(`"value1"`))
+		kv.Set("key2", json.RawMessage(`"value2"`))
+
+		if err := kv.DeleteALL(); err != nil {
+			// Handle the error appropriately
+			log.Printf("Failed to clear all items: %v", err)
+		}
+
+		allItems := kv.GetAll()
+		if len(allItems) != 0 {
+			t.Errorf("Expected 0 items after ClearAll, got %d", len(allItems))
+		}
+	})
+}
+
+func TestDeleteLogging(t *testing.T) {
+	logFile := filepath.Join(t.TempDir(), "transaction.log")
+	opts := herd.LoggerOptions{Durability: herd.DurabilityAlways}
+
+	kv := herd.NewKeyValueStore()
+	if err := kv.InitLogging(logFile, opts, herd.SnapshotOptions{}); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	kv.Set("a", json.RawMessage(`1`)) // revision 1
+
+	t.Run("Missing keys are not logged", func(t *testing.T) {
+		_, ok, err := kv.Delete("missing")
+		if err != nil || ok {
+			t.Fatalf("Expected the missing key not to be found, got %v (%v)", ok, err)
+		}
+		if revision := kv.View().Revision(); revision != 1 {
+			t.Errorf("Expected revision 1, got %d", revision)
+		}
+	})
+
+	t.Run("Expired keys are logged as expirations", func(t *testing.T) {
+		kv.SetWithTTL("short", json.RawMessage(`2`), 10*time.Millisecond) // revision 2
+		time.Sleep(20 * time.Millisecond)
+
+		if _, ok, err := kv.Delete("short"); err != nil || ok {
+			t.Fatalf("Expected the expired key not to be found, got %v (%v)", ok, err)
+		}
+		if revision := kv.View().Revision(); revision != 3 {
+			t.Errorf("Expected the expiration at revision 3, got %d", revision)
+		}
+	})
+
+	kv.Set("b", json.RawMessage(`3`)) // revision 4
+
+	t.Run("Replays the same revisions", func(t *testing.T) {
+		restarted := herd.NewKeyValueStore()
+		if err := restarted.InitLogging(logFile, opts, herd.SnapshotOptions{}); err != nil {
+			t.Fatalf("Unexpected error: %v", err)
+		}
+
+		if revision := restarted.View().Revision(); revision != 4 {
+			t.Errorf("Expected revision 4, got %d", revision)
+		}
+		if _, version, ok := restarted.GetWithVersion("b"); !ok || version != 4 {
+			t.Errorf("Expected b at version 4, got %d (found %v)", version, ok)
+		}
+	})
+}
+
+func TestTTL(t *testing.T) {
+	kv := herd.NewKeyValueStore()
+
+	t.Run("Expires on Get", func(t *testing.T) {
+		kv.SetWithTTL("short", json.RawMessage(`"gone soon"`), 10*time.Millisecond)
+
+		if _, ok := kv.Get("short"); !ok {
+			t.Errorf("Expected key short to exist before its TTL elapsed")
+		}
+
+		time.Sleep(20 * time.Millisecond)
+
+		if _, ok := kv.Get("short"); ok {
+			t.Errorf("Expected key short to be expired")
+		}
+	})
+
+	t.Run("Hidden from GetAll and GetKeys", func(t *testing.T) {
+		if err := kv.DeleteALL(); err != nil {
+			log.Printf("Failed to clear all items: %v", err)
+		}
+
+		kv.Set("forever", json.RawMessage(`"value"`))
+		kv.SetWithTTL("short", json.RawMessage(`"value"`), 10*time.Millisecond)
+		time.Sleep(20 * time.Millisecond)
+
+		if allItems := kv.GetAll(); len(allItems) != 1 {
+			t.Errorf("Expected 1 item, got %d", len(allItems))
+		}
+
+		if keys := kv.GetKeys(); len(keys) != 1 || keys[0] != "forever" {
+			t.Errorf("Expected only key forever, got %v", keys)
+		}
+	})
+
+	t.Run("ReapExpired", func(t *testing.T) {
+		if err := kv.DeleteALL(); err != nil {
+			log.Printf("Failed to clear all items: %v", err)
+		}
+
+		kv.SetWithTTL("key1", json.RawMessage(`"value1"`), 10*time.Millisecond)
+		kv.SetWithTTL("key2", json.RawMessage(`"value2"`), time.Hour)
+		time.Sleep(20 * time.Millisecond)
+
+		if removed := kv.ReapExpired(); removed != 1 {
+			t.Errorf("Expected 1 key to be reaped, got %d", removed)
+		}
+
+		if _, ok := kv.Get("key2"); !ok {
+			t.Errorf("Expected key2 to survive reaping")
+		}
+	})
+}
+
+func TestEviction(t *testing.T) {
+	value := json.RawMessage(`"0123456789"`)
+
+	t.Run("NoEviction", func(t *testing.T) {
+		kv := herd.NewKeyValueStore()
+		kv.SetMemoryLimit(100, herd.NoEviction)
+
+		if err := kv.Set("key1", value); err != nil {
+			t.Fatalf("Unexpected error setting key1: %v", err)
+		}
+
+		if err := kv.Set("key2", value); !errors.Is(err, herd.ErrOutOfMemory) {
+			t.Errorf("Expected ErrOutOfMemory, got %v", err)
+		}
+
+		if _, ok := kv.Get("key1"); !ok {
+			t.Errorf("Expected key1 to be kept under noeviction")
+		}
+	})
+
+	t.Run("AllKeysLRU", func(t *testing.T) {
+		kv := herd.NewKeyValueStore()
+		kv.SetMemoryLimit(100, herd.AllKeysLRU)
+
+		if err := kv.Set("key1", value); err != nil {
+			t.Fatalf("Unexpected error setting key1: %v", err)
+		}
+		if err := kv.Set("key2", value); err != nil {
+			t.Fatalf("Unexpected error setting key2: %v", err)
+		}
+
+		if keys := kv.GetKeys(); len(keys) != 1 || keys[0] != "key2" {
+			t.Errorf("Expected only key2 after eviction, got %v", keys)
+		}
+
+		if used := kv.UsedMemory(); used > 100 {
+			t.Errorf("Expected used memory within limit, got %d", used)
+		}
+	})
+
+	t.Run("Oversize write", func(t *testing.T) {
+		kv := herd.NewKeyValueStore()
+		kv.SetMemoryLimit(100, herd.AllKeysLRU)
+
+		if err := kv.Set("key1", value); err != nil {
+			t.Fatalf("Unexpected error setting key1: %v", err)
+		}
+
+		if err := kv.Set("big", bytes.Repeat([]byte("0"), 200)); !errors.Is(err, herd.ErrOutOfMemory) {
+			t.Errorf("Expected ErrOutOfMemory for a value larger than the limit, got %v", err)
+		}
+
+		if keys := kv.GetKeys(); len(keys) != 1 || keys[0] != "key1" {
+			t.Errorf("Expected key1 to survive the refused write, got %v", keys)
+		}
+	})
+
+	t.Run("VolatileTTL", func(t *testing.T) {
+		kv := herd.NewKeyValueStore()
+		kv.SetMemoryLimit(100, herd.VolatileTTL)
+
+		if err := kv.Set("persistent", value); err != nil {
+			t.Fatalf("Unexpected error setting persistent: %v", err)
+		}
+
+		if err := kv.Set("other", value); !errors.Is(err, herd.ErrOutOfMemory) {
+			t.Errorf("Expected ErrOutOfMemory without volatile keys, got %v", err)
+		}
+	})
+}
+
+func TestVersions(t *testing.T) {
+	kv := herd.NewKeyValueStore()
+
+	t.Run("Versions increase", func(t *testing.T) {
+		v1, err := kv.SetWithOptions("key1", json.RawMessage(`"value1"`), herd.SetOptions{})
+		if err != nil {
+			t.Fatalf("Unexpected error: %v", err)
+		}
+
+		v2, err := kv.SetWithOptions("key1", json.RawMessage(`"value2"`), herd.SetOptions{})
+		if err != nil {
+			t.Fatalf("Unexpected error: %v", err)
+		}
+
+		if v2 <= v1 {
+			t.Errorf("Expected version %d to be greater than %d", v2, v1)
+		}
+
+		if _, version, _ := kv.GetWithVersion("key1"); version != v2 {
+			t.Errorf("Expected Get to return version %d, got %d", v2, version)
+		}
+	})
+
+	t.Run("CompareAndSwap", func(t *testing.T) {
+		_, current, _ := kv.GetWithVersion("key1")
+
+		if _, err := kv.CompareAndSwap("key1", current+1, json.RawMessage(`"stale"`), 0); !errors.Is(err, herd.ErrVersionMismatch) {
+			t.Errorf("Expected ErrVersionMismatch, got %v", err)
+		}
+
+		next, err := kv.CompareAndSwap("key1", current, json.RawMessage(`"swapped"`), 0)
+		if err != nil {
+			t.Fatalf("Unexpected error: %v", err)
+		}
+
+		value, version, _ := kv.GetWithVersion("key1")
+		if string(value) != `"swapped"` || version != next {
+			t.Errorf("Expected swapped value at version %d, got %s at version %d", next, value, version)
+		}
+	})
+
+	t.Run("IfAbsent", func(t *testing.T) {
+		if _, err := kv.SetWithOptions("key1", json.RawMessage(`"value"`), herd.SetOptions{IfAbsent: true}); !errors.Is(err, herd.ErrKeyExists) {
+			t.Errorf("Expected ErrKeyExists, got %v", err)
+		}
+
+		if _, err := kv.CompareAndSwap("key2", 0, json.RawMessage(`"value"`), 0); err != nil {
+			t.Errorf("Expected CompareAndSwap on an absent key with version 0 to succeed, got %v", err)
+		}
+	})
+}
+
+func TestTxn(t *testing.T) {
+	kv := herd.NewKeyValueStore()
+	version, err := kv.SetWithOptions("balance", json.RawMessage(`10`), herd.SetOptions{})
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	t.Run("Then", func(t *testing.T) {
+		result, err := kv.Txn(
+			[]herd.Compare{{Key: "balance", Target: herd.CompareVersion, Result: herd.CompareEqual, Version: version}},
+			[]herd.TxnOp{
+				{Type: herd.TxnSet, Key: "balance", Value: json.RawMessage(`5`)},
+				{Type: herd.TxnSet, Key: "spent", Value: json.RawMessage(`5`)},
+				{Type: herd.TxnGet, Key: "balance"},
+			},
+			nil,
+		)
+		if err != nil {
+			t.Fatalf("Unexpected error: %v", err)
+		}
+
+		if !result.Succeeded || len(result.Results) != 3 {
+			t.Fatalf("Expected the then branch to run 3 ops, got %+v", result)
+		}
+
+		if string(result.Results[2].Value) != `5` || result.Results[2].Version != result.Revision {
+			t.Errorf("Expected to read the transaction's own write, got %+v", result.Results[2])
+		}
+
+		_, balanceVersion, _ := kv.GetWithVersion("balance")
+		_, spentVersion, _ := kv.GetWithVersion("spent")
+		if balanceVersion != spentVersion {
+			t.Errorf("Expected both writes to share a version, got %d and %d", balanceVersion, spentVersion)
+		}
+	})
+
+	t.Run("Else", func(t *testing.T) {
+		result, err := kv.Txn(
+			[]herd.Compare{{Key: "balance", Target: herd.CompareValue, Result: herd.CompareEqual, Value: json.RawMessage(`10`)}},
+			[]herd.TxnOp{{Type: herd.TxnDelete, Key: "balance"}},
+			[]herd.TxnOp{{Type: herd.TxnGet, Key: "missing"}},
+		)
+		if err != nil {
+			t.Fatalf("Unexpected error: %v", err)
+		}
+
+		if result.Succeeded || result.Results[0].Found {
+			t.Errorf("Expected the else branch to read a missing key, got %+v", result)
+		}
+
+		if _, ok := kv.Get("balance"); !ok {
+			t.Errorf("Expected balance to survive a failed transaction")
+		}
+	})
+
+	t.Run("All or nothing", func(t *testing.T) {
+		limited := herd.NewKeyValueStore()
+		limited.SetMemoryLimit(100, herd.NoEviction)
+
+		_, err := limited.Txn(nil, []herd.TxnOp{
+			{Type: herd.TxnSet, Key: "key1", Value: json.RawMessage(`"value1"`)},
+			{Type: herd.TxnSet, Key: "key2", Value: json.RawMessage(`"value2"`)},
+		}, nil)
+		if !errors.Is(err, herd.ErrOutOfMemory) {
+			t.Errorf("Expected ErrOutOfMemory, got %v", err)
+		}
+
+		if keys := limited.GetKeys(); len(keys) != 0 {
+			t.Errorf("Expected no keys after a rejected transaction, got %v", keys)
+		}
+	})
+}
+
+func TestWatch(t *testing.T) {
+	kv := herd.NewKeyValueStore()
+	first, _ := kv.SetWithOptions("config/a", json.RawMessage(`1`), herd.SetOptions{})
+
+	w, err := kv.Watch(herd.WatchFilter{Prefix: "config/"}, first)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	defer w.Close()
+
+	kv.Set("other", json.RawMessage(`2`))
+	kv.Delete("config/a")
+
+	expected := []herd.EventType{herd.EventSet, herd.EventDelete}
+	for i, eventType := range expected {
+		select {
+		case ev := <-w.Events():
+			if ev.Type != eventType || ev.Key != "config/a" {
+				t.Errorf("Event %d: expected type %d for config/a, got %+v", i, eventType, ev)
+			}
+		case <-time.After(time.Second):
+			t.Fatalf("Timed out waiting for event %d", i)
+		}
+	}
+
+	t.Run("Slow watcher", func(t *testing.T) {
+		slow, err := kv.Watch(herd.WatchFilter{}, 0)
+		if err != nil {
+			t.Fatalf("Unexpected error: %v", err)
+		}
+		defer slow.Close()
+
+		for range 2000 {
+			kv.Set("key", json.RawMessage(`"value"`))
+		}
+
+		for range slow.Events() {
+			// drain until the channel is closed
+		}
+
+		if !errors.Is(slow.Err(), herd.ErrWatcherTooSlow) {
+			t.Errorf("Expected ErrWatcherTooSlow, got %v", slow.Err())
+		}
+	})
+
+	t.Run("Compacted", func(t *testing.T) {
+		if _, err := kv.Watch(herd.WatchFilter{}, first); !errors.Is(err, herd.ErrCompacted) {
+			t.Errorf("Expected ErrCompacted, got %v", err)
+		}
+	})
+}
+
+func TestScan(t *testing.T) {
+	kv := herd.NewKeyValueStore()
+	for _, key := range []string{"user/3", "user/1", "order/1", "user/2", "userx"} {
+		kv.Set(key, json.RawMessage(`"value"`))
+	}
+
+	t.Run("Prefix with pagination", func(t *testing.T) {
+		var keys []string
+		opts := herd.ScanOptions{Prefix: "user/", Limit: 2}
+		for {
+			result, err := kv.Scan(opts)
+			if err != nil {
+				t.Fatalf("Unexpected error: %v", err)
+			}
+
+			for _, item := range result.Items {
+				keys = append(keys, item.Key)
+			}
+
+			if result.ContinuationToken == "" {
+				break
+			}
+			opts.ContinuationToken = result.ContinuationToken
+		}
+
+		expected := []string{"user/1", "user/2", "user/3"}
+		if !slices.Equal(keys, expected) {
+			t.Errorf("Expected %v, got %v", expected, keys)
+		}
+	})
+
+	t.Run("Range", func(t *testing.T) {
+		result, err := kv.Scan(herd.ScanOptions{Start: "order/", End: "user/2"})
+		if err != nil {
+			t.Fatalf("Unexpected error: %v", err)
+		}
+
+		if len(result.Items) != 2 || result.Items[0].Key != "order/1" || result.Items[1].Key != "user/1" {
+			t.Errorf("Unexpected range result: %+v", result.Items)
+		}
+	})
+
+	t.Run("Invalid", func(t *testing.T) {
+		if _, err := kv.Scan(herd.ScanOptions{Prefix: "user/", Start: "a"}); !errors.Is(err, herd.ErrInvalidScan) {
+			t.Errorf("Expected ErrInvalidScan, got %v", err)
+		}
+	})
+}
+
+func TestView(t *testing.T) {
+	kv := herd.NewKeyValueStore()
+	kv.Set("key1", json.RawMessage(`"value1"`))
+	kv.Set("key2", json.RawMessage(`"value2"`))
+
+	view := kv.View()
+
+	// Writes after the view is taken must not be visible through it
+	kv.Set("key1", json.RawMessage(`"changed"`))
+	kv.Set("key3", json.RawMessage(`"value3"`))
+	kv.Delete("key2")
+
+	var items []string
+	view.Ascend(func(item herd.ScanItem) bool {
+		items = append(items, item.Key+"="+string(item.Value))
+		return true
+	})
+
+	expected := []string{`key1="value1"`, `key2="value2"`}
+	if !slices.Equal(items, expected) {
+		t.Errorf("Expected %v, got %v", expected, items)
+	}
+}
+
+// allStream collects the responses of a StreamAll call.
+type allStream struct {
+	grpc.ServerStream
+	responses []*proto.GetAllResponse
+}
+
+func (s *allStream) Send(res *proto.GetAllResponse) error {
+	s.responses = append(s.responses, res)
+	return nil
+}
+
+func TestStreamChunkSize(t *testing.T) {
+	server := herd.NewGRPCServer()
+	for i := range 3 {
+		if _, err := server.Set(context.Background(), &proto.SetRequest{Key: fmt.Sprintf("key%d", i), Value: []byte("value")}); err != nil {
+			t.Fatalf("Unexpected error: %v", err)
+		}
+	}
+
+	// A huge chunk size from a client must be capped instead of being allocated up front
+	stream := &allStream{}
+	if err := server.StreamAll(&proto.StreamRequest{ChunkSize: math.MaxUint32}, stream); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if len(stream.responses) != 1 || len(stream.responses[0].GetItems()) != 3 {
+		t.Errorf("Expected a single chunk of 3 items, got %v", stream.responses)
+	}
+}
+
+func TestMulti(t *testing.T) {
+	kv := herd.NewKeyValueStore()
+
+	version, err := kv.MultiSet([]herd.KeyValue{
+		{Key: "feature1", Value: json.RawMessage(`0.1`)},
+		{Key: "feature2", Value: json.RawMessage(`0.2`)},
+	}, 0)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	results := kv.MultiGet([]string{"feature1", "missing", "feature2"})
+	if len(results) != 3 || !results[0].Found || results[1].Found || !results[2].Found {
+		t.Fatalf("Unexpected MultiGet results: %+v", results)
+	}
+
+	if string(results[2].Value) != `0.2` || results[0].Version != version || results[2].Version != version {
+		t.Errorf("Expected both features at version %d, got %+v", version, results)
+	}
+
+	deleted, err := kv.MultiDelete([]string{"feature1", "missing"})
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	if !deleted[0].Found || string(deleted[0].Value) != `0.1` || deleted[1].Found {
+		t.Errorf("Unexpected MultiDelete results: %+v", deleted)
+	}
+
+	if keys := kv.GetKeys(); len(keys) != 1 || keys[0] != "feature2" {
+		t.Errorf("Expected only feature2 to remain, got %v", keys)
+	}
+}
+
+func TestErrors(t *testing.T) {
+	kv := herd.NewKeyValueStore()
+
+	kv.Set("key1", json.RawMessage(`"value"`))
+
+	_, err := kv.SetWithOptions("key1", json.RawMessage(`"other"`), herd.SetOptions{IfAbsent: true})
+	var keyErr *herd.KeyError
+	if !errors.As(err, &keyErr) || keyErr.Key != "key1" || !errors.Is(err, herd.ErrKeyExists) {
+		t.Errorf("Expected KeyError for key1 wrapping ErrKeyExists, got %v", err)
+	}
+
+	_, err = kv.Scan(herd.ScanOptions{ContinuationToken: "!"})
+	var fieldErr *herd.FieldError
+	if !errors.As(err, &fieldErr) || fieldErr.Field != "continuation_token" {
+		t.Errorf("Expected FieldError for continuation_token, got %v", err)
+	}
+
+	if !errors.Is(err, herd.ErrInvalidScan) || !errors.Is(err, herd.ErrInvalidArgument) {
+		t.Errorf("Expected error to wrap ErrInvalidScan and ErrInvalidArgument, got %v", err)
+	}
+}
+
+func TestLogRecords(t *testing.T) {
+	logFile := filepath.Join(t.TempDir(), "transaction.log")
+	logger, err := herd.NewLogger(logFile, herd.LoggerOptions{Durability: herd.DurabilityAlways})
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	defer logger.Close()
+
+	now := time.Now()
+	writeLogs(t, logger,
+		herd.LogEntry{Timestamp: now, Operation: "SET", Key: "a, Key: b", Value: []byte("line1\nline2"), Revision: 1},
+		herd.LogEntry{Timestamp: now, Operation: "TXN", Revision: 2, Batch: []herd.LogEntry{
+			{Timestamp: now, Operation: "SET", Key: "k", Value: []byte{0, 0xff}, ExpiresAt: now.Add(time.Hour)},
+			{Timestamp: now, Operation: "DELETE", Key: "a, Key: b"},
+		}},
+	)
+
+	t.Run("Round trip", func(t *testing.T) {
+		entries, readErr := logger.ReadLogs(herd.LogPosition{})
+		if readErr != nil {
+			t.Fatalf("Unexpected error: %v", readErr)
+		}
+
+		if len(entries) != 2 || entries[0].Key != "a, Key: b" || string(entries[0].Value) != "line1\nline2" {
+			t.Fatalf("Unexpected entries: %+v", entries)
+		}
+
+		batch := entries[1].Batch
+		if entries[1].Revision != 2 || len(batch) != 2 || string(batch[0].Value) != "\x00\xff" || !batch[0].ExpiresAt.Equal(now.Add(time.Hour)) {
+			t.Errorf("Unexpected transaction: %+v", entries[1])
+		}
+	})
+
+	segment := filepath.Join(filepath.Dir(logFile), "transaction-00000001.log")
+	t.Run("Truncates torn tail", func(t *testing.T) {
+		complete, _ := os.ReadFile(segment)
+		appendFile(t, segment, []byte{42, 0, 0, 0, 1, 2})
+
+		entries, readErr := logger.ReadLogs(herd.LogPosition{})
+		if readErr != nil || len(entries) != 2 {
+			t.Fatalf("Expected the 2 complete entries, got %d: %v", len(entries), readErr)
+		}
+
+		if data, _ := os.ReadFile(segment); len(data) != len(complete) {
+			t.Errorf("Expected the log to be truncated to %d bytes, got %d", len(complete), len(data))
+		}
+	})
+
+	t.Run("Reports corrupt length", func(t *testing.T) {
+		complete, _ := os.ReadFile(segment)
+		for _, length := range []uint32{uint32(len(complete)), 1 << 31} {
+			// The length of the first record, after the segment header, runs past the end of the log
+			data := bytes.Clone(complete)
+			binary.LittleEndian.PutUint32(data[8:], length)
+			if writeErr := os.WriteFile(segment, data, 0644); writeErr != nil {
+				t.Fatalf("Unexpected error: %v", writeErr)
+			}
+
+			if _, readErr := logger.ReadLogs(herd.LogPosition{}); !errors.Is(readErr, herd.ErrCorruptLog) {
+				t.Errorf("Expected ErrCorruptLog for length %d, got %v", length, readErr)
+			}
+			if kept, _ := os.ReadFile(segment); len(kept) != len(complete) {
+				t.Errorf("Expected the log to be kept at %d bytes, got %d", len(complete), len(kept))
+			}
+		}
+
+		if writeErr := os.WriteFile(segment, complete, 0644); writeErr != nil {
+			t.Fatalf("Unexpected error: %v", writeErr)
+		}
+	})
+
+	t.Run("Reports corruption", func(t *testing.T) {
+		data, _ := os.ReadFile(segment)
+		data[20] ^= 0xff
+		if writeErr := os.WriteFile(segment, data, 0644); writeErr != nil {
+			t.Fatalf("Unexpected error: %v", writeErr)
+		}
+
+		if _, readErr := logger.ReadLogs(herd.LogPosition{}); !errors.Is(readErr, herd.ErrCorruptLog) {
+			t.Errorf("Expected ErrCorruptLog, got %v", readErr)
+		}
+	})
+}
+
+func TestLegacyLog(t *testing.T) {
+	logFile := filepath.Join(t.TempDir(), "transaction.log")
+	legacy := "[2024-11-20T10:00:00Z] SET - Key: key1, Value: \"value1\"\n" +
+		"[2024-11-20T10:00:00Z] GET - Key: key1, Value: \"value1\"\n" +
+		"[2024-11-20T10:00:01Z] SET - Key: key2, Value: {\"a\":1}\n"
+	if err := os.WriteFile(logFile, []byte(legacy), 0644); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	logger, err := herd.NewLogger(logFile, herd.LoggerOptions{})
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	defer logger.Close()
+
+	entries, err := logger.ReadLogs(herd.LogPosition{})
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	if len(entries) != 2 || string(entries[0].Value) != `"value1"` || entries[1].Key != "key2" || string(entries[1].Value) != `{"a":1}` {
+		t.Errorf("Unexpected entries: %+v", entries)
+	}
+
+	t.Run("Keeps a log it can't convert", func(t *testing.T) {
+		damagedFile := filepath.Join(t.TempDir(), "transaction.log")
+		damaged := legacy + "[2024-11-20T10:00:02Z] SET\n"
+		if writeErr := os.WriteFile(damagedFile, []byte(damaged), 0644); writeErr != nil {
+			t.Fatalf("Unexpected error: %v", writeErr)
+		}
+
+		if _, newErr := herd.NewLogger(damagedFile, herd.LoggerOptions{}); !errors.Is(newErr, herd.ErrCorruptLog) {
+			t.Errorf("Expected ErrCorruptLog, got %v", newErr)
+		}
+		if data, _ := os.ReadFile(damagedFile); string(data) != damaged {
+			t.Errorf("Expected the text log to be left in place, got %q", data)
+		}
+	})
+}
+
+func TestLogSegments(t *testing.T) {
+	logDir := t.TempDir()
+	logger, err := herd.NewLogger(filepath.Join(logDir, "transaction.log"), herd.LoggerOptions{
+		Durability:  herd.DurabilityAlways,
+		SegmentSize: 256,
+	})
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	defer logger.Close()
+
+	var middle herd.LogPosition
+	for i := range 50 {
+		if i == 25 {
+			if middle, err = logger.Position(); err != nil {
+				t.Fatalf("Unexpected error: %v", err)
+			}
+		}
+		writeLogs(t, logger, herd.LogEntry{Timestamp: time.Now(), Operation: "SET", Key: "key", Value: []byte("value"), Revision: uint64(i + 1)})
+	}
+
+	segments, _ := filepath.Glob(filepath.Join(logDir, "transaction-*.log"))
+	if len(segments) < 3 {
+		t.Fatalf("Expected the log to be split into several segments, got %v", segments)
+	}
+
+	entries, err := logger.ReadLogs(middle)
+	if err != nil || len(entries) != 25 || entries[0].Revision != 26 {
+		t.Fatalf("Expected the 25 entries after the middle position, got %d: %v", len(entries), err)
+	}
+
+	removed, err := logger.RemoveSegmentsBefore(middle.Segment)
+	if err != nil || removed != int(middle.Segment)-1 {
+		t.Errorf("Expected %d segments to be removed, got %d: %v", middle.Segment-1, removed, err)
+	}
+
+	if entries, err = logger.ReadLogs(middle); err != nil || len(entries) != 25 {
+		t.Errorf("Expected the entries after the middle position to remain, got %d: %v", len(entries), err)
+	}
+
+	if _, err = logger.ReadLogs(herd.LogPosition{Segment: 1}); !errors.Is(err, herd.ErrCorruptLog) {
+		t.Errorf("Expected reading from a removed segment to fail with ErrCorruptLog, got %v", err)
+	}
+}
+
+func TestSnapshotRecovery(t *testing.T) {
+	logFile := filepath.Join(t.TempDir(), "transaction.log")
+	opts := herd.LoggerOptions{Durability: herd.DurabilityAlways}
+
+	kv := herd.NewKeyValueStore()
+	if err := kv.InitLogging(logFile, opts, herd.SnapshotOptions{}); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	kv.Set("before", json.RawMessage(`1`))
+	kv.Set("changed", json.RawMessage(`"old"`))
+	if _, err := kv.TakeSnapshot(); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	kv.Set("changed", json.RawMessage(`"new"`))
+	kv.Delete("before")
+	kv.Set("after", json.RawMessage(`2`))
+
+	restored := herd.NewKeyValueStore()
+	if err := restored.InitLogging(logFile, opts, herd.SnapshotOptions{}); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	keys := restored.GetKeys()
+	slices.Sort(keys)
+	if !slices.Equal(keys, []string{"after", "changed"}) {
+		t.Errorf("Expected keys [after changed], got %v", keys)
+	}
+
+	if value, _ := restored.Get("changed"); string(value) != `"new"` {
+		t.Errorf("Expected changed to be \"new\", got %s", value)
+	}
+
+	t.Run("Falls back past a corrupt snapshot", func(t *testing.T) {
+		corrupt := filepath.Join(filepath.Dir(logFile), "snapshot_99991231235959.json")
+		if err := os.WriteFile(corrupt, []byte(`{"data": {"after": `), 0600); err != nil {
+			t.Fatalf("Unexpected error: %v", err)
+		}
+
+		fallback := herd.NewKeyValueStore()
+		if err := fallback.InitLogging(logFile, opts, herd.SnapshotOptions{}); err != nil {
+			t.Fatalf("Unexpected error: %v", err)
+		}
+
+		if value, _ := fallback.Get("after"); string(value) != `2` {
+			t.Errorf("Expected after to be restored from the log, got %s", value)
+		}
+	})
+}
+
+func TestSnapshotFormat(t *testing.T) {
+	if _, err := herd.ParseSnapshotCompression("gzip"); err == nil {
+		t.Errorf("Expected an error for an unknown snapshot compression")
+	}
+
+	for _, compression := range []herd.SnapshotCompression{herd.CompressionNone, herd.CompressionZstd, herd.CompressionSnappy} {
+		t.Run(string(compression), func(t *testing.T) {
+			logFile := filepath.Join(t.TempDir(), "transaction.log")
+			opts := herd.LoggerOptions{Durability: herd.DurabilityAlways}
+			snapshots := herd.SnapshotOptions{Compression: compression}
+
+			kv := herd.NewKeyValueStore()
+			if err := kv.InitLogging(logFile, opts, snapshots); err != nil {
+				t.Fatalf("Unexpected error: %v", err)
+			}
+
+			for i := range 100 {
+				kv.Set(fmt.Sprintf("key%d", i), json.RawMessage(`{"n": `+strconv.Itoa(i)+`}`))
+			}
+			if _, err := kv.SetWithOptions("volatile", json.RawMessage(`"soon"`), herd.SetOptions{TTL: time.Hour}); err != nil {
+				t.Fatalf("Unexpected error: %v", err)
+			}
+			if _, err := kv.TakeSnapshot(); err != nil {
+				t.Fatalf("Unexpected error: %v", err)
+			}
+
+			// The snapshot covers the whole log, so everything is restored from it
+			restored := herd.NewKeyValueStore()
+			if err := restored.InitLogging(logFile, opts, snapshots); err != nil {
+				t.Fatalf("Unexpected error: %v", err)
+			}
+
+			if keys := restored.GetKeys(); len(keys) != 101 {
+				t.Errorf("Expected 101 keys, got %d", len(keys))
+			}
+			if value, _ := restored.Get("key42"); string(value) != `{"n": 42}` {
+				t.Errorf("Expected key42 to be restored, got %s", value)
+			}
+			_, want, _ := kv.GetWithVersion("volatile")
+			if value, version, ok := restored.GetWithVersion("volatile"); !ok || version != want {
+				t.Errorf("Expected volatile at version %d, got %s at version %d", want, value, version)
+			}
+			if restored.View().Revision() != kv.View().Revision() {
+				t.Errorf("Expected revision %d, got %d", kv.View().Revision(), restored.View().Revision())
+			}
+
+			// A damaged snapshot fails its checksum instead of being restored
+			files, _ := filepath.Glob(filepath.Join(filepath.Dir(logFile), "snapshot_*.snap"))
+			if len(files) != 1 {
+				t.Fatalf("Expected one snapshot file, got %v", files)
+			}
+			data, _ := os.ReadFile(files[0])
+			data[len(data)/2] ^= 0xff
+			if err := os.WriteFile(files[0], data, 0600); err != nil {
+				t.Fatalf("Unexpected error: %v", err)
+			}
+
+			if err := herd.NewKeyValueStore().InitLogging(logFile, opts, snapshots); !errors.Is(err, herd.ErrCorruptSnapshot) {
+				t.Errorf("Expected ErrCorruptSnapshot, got %v", err)
+			}
+		})
+	}
+
+	t.Run("Reads JSON snapshots", func(t *testing.T) {
+		logFile := filepath.Join(t.TempDir(), "transaction.log")
+		legacy := `{"data": {"a": 1, "b": {"c": true}}, "timestamp": "2024-01-01T00:00:00Z"}`
+		if err := os.WriteFile(filepath.Join(filepath.Dir(logFile), "snapshot_20240101000000.json"), []byte(legacy), 0600); err != nil {
+			t.Fatalf("Unexpected error: %v", err)
+		}
+
+		kv := herd.NewKeyValueStore()
+		if err := kv.InitLogging(logFile, herd.LoggerOptions{}, herd.SnapshotOptions{}); err != nil {
+			t.Fatalf("Unexpected error: %v", err)
+		}
+
+		// Values are left untagged, as they are when migrated from the text log
+		if item, _ := kv.GetItem("b"); string(item.Value) != `{"c": true}` || item.ContentType != "" {
+			t.Errorf("Expected b to be restored without a content type, got %s (%q)", item.Value, item.ContentType)
+		}
+		if _, version, _ := kv.GetWithVersion("a"); version != 1 {
+			t.Errorf("Expected unversioned keys to be numbered in key order, got version %d for a", version)
+		}
+	})
+}
+
+func TestSnapshotAdmin(t *testing.T) {
+	logFile := filepath.Join(t.TempDir(), "transaction.log")
+	opts := herd.LoggerOptions{Durability: herd.DurabilityAlways, SegmentSize: 1}
+	snapshots := herd.SnapshotOptions{Retain: 3}
+
+	kv := herd.NewKeyValueStore()
+	if err := kv.InitLogging(logFile, opts, snapshots); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	var first herd.SnapshotInfo
+	for i := range 5 {
+		kv.Set("counter", json.RawMessage(strconv.Itoa(i)))
+		kv.Set(fmt.Sprintf("key%d", i), json.RawMessage(`true`))
+		info, err := kv.TakeSnapshot()
+		if err != nil {
+			t.Fatalf("Unexpected error: %v", err)
+		}
+		if i == 2 {
+			first = info
+		}
+	}
+
+	t.Run("Keeps the most recent snapshots", func(t *testing.T) {
+		list, err := kv.Snapshots()
+		if err != nil || len(list) != 3 {
+			t.Fatalf("Expected 3 snapshots, got %d: %v", len(list), err)
+		}
+		if list[0].Name != first.Name || list[0].Keys != 4 || list[2].Keys != 6 {
+			t.Errorf("Expected the last 3 snapshots oldest first, got %+v", list)
+		}
+	})
+
+	t.Run("Orders snapshots by when they were taken", func(t *testing.T) {
+		// The name sorts after every other snapshot, but the snapshot is older
+		legacy := filepath.Join(filepath.Dir(logFile), "snapshot_99991231235959.json")
+		if err := os.WriteFile(legacy, []byte(`{"data": {"old": 1}, "timestamp": "2001-01-01T00:00:00Z"}`), 0600); err != nil {
+			t.Fatalf("Unexpected error: %v", err)
+		}
+
+		if list, _ := kv.Snapshots(); len(list) != 4 || list[0].Name != filepath.Base(legacy) {
+			t.Errorf("Expected the JSON snapshot to be listed first, got %+v", list)
+		}
+
+		if err := kv.DeleteSnapshot(filepath.Base(legacy)); err != nil {
+			t.Errorf("Unexpected error: %v", err)
+		}
+	})
+
+	t.Run("Restores a snapshot", func(t *testing.T) {
+		before := kv.View().Revision()
+		revision, err := kv.RestoreSnapshot(first.Name)
+		if err != nil {
+			t.Fatalf("Unexpected error: %v", err)
+		}
+		if revision <= before {
+			t.Errorf("Expected the revision to move forward from %d, got %d", before, revision)
+		}
+
+		if value, _ := kv.Get("counter"); string(value) != `2` {
+			t.Errorf("Expected counter to be 2, got %s", value)
+		}
+		if _, ok := kv.Get("key4"); ok {
+			t.Errorf("Expected key4 to be gone after the restore")
+		}
+
+		restored := herd.NewKeyValueStore()
+		if err = restored.InitLogging(logFile, opts, snapshots); err != nil {
+			t.Fatalf("Unexpected error: %v", err)
+		}
+		if keys := restored.GetKeys(); len(keys) != 4 {
+			t.Errorf("Expected the restore to survive a restart with 4 keys, got %v", keys)
+		}
+	})
+
+	t.Run("Restores a snapshot larger than a log record", func(t *testing.T) {
+		large := herd.NewKeyValueStore()
+		if err := large.InitLogging(filepath.Join(t.TempDir(), "transaction.log"), herd.LoggerOptions{}, herd.SnapshotOptions{}); err != nil {
+			t.Fatalf("Unexpected error: %v", err)
+		}
+
+		value := bytes.Repeat([]byte("x"), 1<<20)
+		for i := range 70 {
+			large.Set(fmt.Sprintf("key%d", i), value)
+		}
+		info, err := large.TakeSnapshot()
+		if err != nil {
+			t.Fatalf("Unexpected error: %v", err)
+		}
+
+		if err = large.DeleteALL(); err != nil {
+			t.Fatalf("Unexpected error: %v", err)
+		}
+		if _, err = large.RestoreSnapshot(info.Name); err != nil {
+			t.Fatalf("Unexpected error: %v", err)
+		}
+		if keys := large.GetKeys(); len(keys) != 70 {
+			t.Errorf("Expected 70 keys after the restore, got %d", len(keys))
+		}
+	})
+
+	t.Run("Deletes snapshots", func(t *testing.T) {
+		if err := kv.DeleteSnapshot("snapshot_missing.snap"); !errors.Is(err, herd.ErrSnapshotNotFound) {
+			t.Errorf("Expected ErrSnapshotNotFound, got %v", err)
+		}
+
+		list, _ := kv.Snapshots()
+		for _, info := range list[:len(list)-1] {
+			if err := kv.DeleteSnapshot(info.Name); err != nil {
+				t.Errorf("Unexpected error: %v", err)
+			}
+		}
+
+		// The log before the last snapshot is gone, so recovery depends on it
+		if err := kv.DeleteSnapshot(list[len(list)-1].Name); !errors.Is(err, herd.ErrSnapshotRequired) {
+			t.Errorf("Expected ErrSnapshotRequired, got %v", err)
+		}
+	})
+
+	t.Run("Keeps snapshots taken together", func(t *testing.T) {
+		together := herd.NewKeyValueStore()
+		if err := together.InitLogging(filepath.Join(t.TempDir(), "transaction.log"), opts, herd.SnapshotOptions{Retain: 5}); err != nil {
+			t.Fatalf("Unexpected error: %v", err)
+		}
+
+		// Without writes in between, the snapshots share a revision and likely a millisecond
+		for range 3 {
+			if _, err := together.TakeSnapshot(); err != nil {
+				t.Fatalf("Unexpected error: %v", err)
+			}
+		}
+
+		if list, err := together.Snapshots(); err != nil || len(list) != 3 {
+			t.Errorf("Expected 3 snapshots, got %d: %v", len(list), err)
+		}
+	})
+
+	t.Run("Requires logging", func(t *testing.T) {
+		if _, err := herd.NewKeyValueStore().Snapshots(); !errors.Is(err, herd.ErrLoggingDisabled) {
+			t.Errorf("Expected ErrLoggingDisabled, got %v", err)
+		}
+	})
+}
+
+func TestPointInTimeRecovery(t *testing.T) {
+	logFile := filepath.Join(t.TempDir(), "transaction.log")
+	opts := herd.LoggerOptions{Durability: herd.DurabilityAlways}
+
+	kv := herd.NewKeyValueStore()
+	if err := kv.InitLogging(logFile, opts, herd.SnapshotOptions{}); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	kv.Set("a", json.RawMessage(`1`)) // revision 1
+	kv.Set("a", json.RawMessage(`2`)) // revision 2
+	if _, err := kv.TakeSnapshot(); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	kv.Set("b", json.RawMessage(`1`)) // revision 3
+	time.Sleep(10 * time.Millisecond)
+	target := time.Now()
+	time.Sleep(10 * time.Millisecond)
+	kv.Set("a", json.RawMessage(`3`)) // revision 4
+	kv.Delete("b")                    // revision 5
+
+	t.Run("Recovers to a time", func(t *testing.T) {
+		recovered := herd.NewKeyValueStore()
+		err := recovered.InitLoggingTo(logFile, opts, herd.SnapshotOptions{Retain: 10}, herd.RecoveryTarget{Time: target})
+		if err != nil {
+			t.Fatalf("Unexpected error: %v", err)
+		}
+
+		if value, _ := recovered.Get("a"); string(value) != `2` {
+			t.Errorf("Expected a to be 2, got %s", value)
+		}
+		if value, _ := recovered.Get("b"); string(value) != `1` {
+			t.Errorf("Expected b to be 1, got %s", value)
+		}
+		if revision := recovered.View().Revision(); revision != 5 {
+			t.Errorf("Expected revisions to continue from 5, got %d", revision)
+		}
+	})
+
+	t.Run("Recovers offline to a revision before every snapshot", func(t *testing.T) {
+		info, err := herd.RecoverSnapshot(logFile, opts, herd.SnapshotOptions{Retain: 10}, herd.RecoveryTarget{Revision: 1})
+		if err != nil {
+			t.Fatalf("Unexpected error: %v", err)
+		}
+		if info.Keys != 1 {
+			t.Errorf("Expected a snapshot with 1 key, got %d", info.Keys)
+		}
+
+		// The server starts from the recovered snapshot
+		restarted := herd.NewKeyValueStore()
+		if err = restarted.InitLogging(logFile, opts, herd.SnapshotOptions{Retain: 10}); err != nil {
+			t.Fatalf("Unexpected error: %v", err)
+		}
+
+		if keys := restarted.GetKeys(); len(keys) != 1 {
+			t.Errorf("Expected only a, got %v", keys)
+		}
+		if value, version, _ := restarted.GetWithVersion("a"); string(value) != `1` || version != 1 {
+			t.Errorf("Expected a to be 1 at version 1, got %s at version %d", value, version)
+		}
+
+		revision, err := restarted.SetWithOptions("c", json.RawMessage(`true`), herd.SetOptions{})
+		if err != nil || revision != 6 {
+			t.Errorf("Expected the next write at revision 6, got %d: %v", revision, err)
+		}
+	})
+}
+
+func TestBackup(t *testing.T) {
+	kv := herd.NewKeyValueStore()
+	for i := range 50 {
+		kv.Set(fmt.Sprintf("key%d", i), json.RawMessage(strconv.Itoa(i)))
+	}
+
+	var backup bytes.Buffer
+	if err := kv.Backup(&backup, herd.CompressionZstd); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	kv.Set("key0", json.RawMessage(`"changed"`))
+	kv.Set("extra", json.RawMessage(`true`))
+
+	t.Run("Rejects a damaged backup", func(t *testing.T) {
+		damaged := bytes.Clone(backup.Bytes())
+		damaged[len(damaged)/2] ^= 0xff
+		if _, err := kv.Restore(bytes.NewReader(damaged), herd.RestoreReplace); !errors.Is(err, herd.ErrCorruptSnapshot) {
+			t.Errorf("Expected ErrCorruptSnapshot, got %v", err)
+		}
+		if _, ok := kv.Get("extra"); !ok {
+			t.Errorf("Expected the store to be left unchanged")
+		}
+	})
+
+	t.Run("Merges a backup", func(t *testing.T) {
+		revision, err := kv.Restore(bytes.NewReader(backup.Bytes()), herd.RestoreMerge)
+		if err != nil {
+			t.Fatalf("Unexpected error: %v", err)
+		}
+
+		if value, version, _ := kv.GetWithVersion("key0"); string(value) != `0` || version != revision {
+			t.Errorf("Expected key0 to be restored at revision %d, got %s at version %d", revision, value, version)
+		}
+		if _, ok := kv.Get("extra"); !ok {
+			t.Errorf("Expected extra to be kept by a merge")
+		}
+	})
+
+	t.Run("Replaces the store with a backup", func(t *testing.T) {
+		if _, err := kv.Restore(bytes.NewReader(backup.Bytes()), herd.RestoreReplace); err != nil {
+			t.Fatalf("Unexpected error: %v", err)
+		}
+
+		if _, ok := kv.Get("extra"); ok {
+			t.Errorf("Expected extra to be deleted by a replace")
+		}
+		if keys := kv.GetKeys(); len(keys) != 50 {
+			t.Errorf("Expected 50 keys, got %d", len(keys))
+		}
+	})
+
+	t.Run("Persists a restore", func(t *testing.T) {
+		logFile := filepath.Join(t.TempDir(), "transaction.log")
+		opts := herd.LoggerOptions{Durability: herd.DurabilityAlways}
+
+		logged := herd.NewKeyValueStore()
+		if err := logged.InitLogging(logFile, opts, herd.SnapshotOptions{}); err != nil {
+			t.Fatalf("Unexpected error: %v", err)
+		}
+		logged.Set("extra", json.RawMessage(`true`))
+		if _, err := logged.Restore(bytes.NewReader(backup.Bytes()), herd.RestoreMerge); err != nil {
+			t.Fatalf("Unexpected error: %v", err)
+		}
+
+		restarted := herd.NewKeyValueStore()
+		if err := restarted.InitLogging(logFile, opts, herd.SnapshotOptions{}); err != nil {
+			t.Fatalf("Unexpected error: %v", err)
+		}
+		if keys := restarted.GetKeys(); len(keys) != 51 {
+			t.Errorf("Expected 51 keys after a restart, got %d", len(keys))
+		}
+	})
+
+	t.Run("Restores a backup larger than a log record", func(t *testing.T) {
+		large := herd.NewKeyValueStore()
+		value := bytes.Repeat([]byte("x"), 1<<20)
+		for i := range 70 {
+			large.Set(fmt.Sprintf("key%d", i), value)
+		}
+
+		var largeBackup bytes.Buffer
+		if err := large.Backup(&largeBackup, herd.CompressionNone); err != nil {
+			t.Fatalf("Unexpected error: %v", err)
+		}
+
+		logFile := filepath.Join(t.TempDir(), "transaction.log")
+		opts := herd.LoggerOptions{Durability: herd.DurabilityAlways}
+
+		logged := herd.NewKeyValueStore()
+		if err := logged.InitLogging(logFile, opts, herd.SnapshotOptions{}); err != nil {
+			t.Fatalf("Unexpected error: %v", err)
+		}
+
+		// A snapshot from before the restore keeps the log it is written to
+		if _, err := logged.TakeSnapshot(); err != nil {
+			t.Fatalf("Unexpected error: %v", err)
+		}
+		revision, err := logged.Restore(bytes.NewReader(largeBackup.Bytes()), herd.RestoreReplace)
+		if err != nil {
+			t.Fatalf("Unexpected error: %v", err)
+		}
+
+		// Recovery has to replay the restore from the log without the snapshot taken after it
+		snapshots, err := logged.Snapshots()
+		if err != nil || len(snapshots) != 2 {
+			t.Fatalf("Expected 2 snapshots, got %d: %v", len(snapshots), err)
+		}
+		if err = logged.DeleteSnapshot(snapshots[1].Name); err != nil {
+			t.Fatalf("Unexpected error: %v", err)
+		}
+
+		restarted := herd.NewKeyValueStore()
+		if err = restarted.InitLogging(logFile, opts, herd.SnapshotOptions{}); err != nil {
+			t.Fatalf("Unexpected error: %v", err)
+		}
+		if keys := restarted.GetKeys(); len(keys) != 70 {
+			t.Errorf("Expected 70 keys after a restart, got %d", len(keys))
+		}
+		if restored, version, _ := restarted.GetWithVersion("key69"); !bytes.Equal(restored, value) || version != revision {
+			t.Errorf("Expected key69 to be restored at revision %d, got %d bytes at version %d", revision, len(restored), version)
+		}
+	})
+}
+
+func TestBinaryValues(t *testing.T) {
+	logFile := filepath.Join(t.TempDir(), "transaction.log")
+	opts := herd.LoggerOptions{Durability: herd.DurabilityAlways}
+
+	blob := make([]byte, 256)
+	for i := range blob {
+		blob[i] = byte(i)
+	}
+
+	kv := herd.NewKeyValueStore()
+	if err := kv.InitLogging(logFile, opts, herd.SnapshotOptions{}); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	t.Run("Rejects invalid JSON declared as JSON", func(t *testing.T) {
+		_, err := kv.SetWithOptions("doc", blob, herd.SetOptions{ContentType: herd.ContentTypeJSON})
+		if !errors.Is(err, herd.ErrInvalidArgument) {
+			t.Errorf("Expected ErrInvalidArgument, got %v", err)
+		}
+		if _, err = kv.SetWithOptions("doc", blob, herd.SetOptions{ContentType: "not a media type"}); !errors.Is(err, herd.ErrInvalidArgument) {
+			t.Errorf("Expected ErrInvalidArgument for an invalid content type, got %v", err)
+		}
+	})
+
+	if err := kv.Set("snapshotted", blob); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if _, err := kv.SetWithOptions("doc", []byte(`{"a":1}`), herd.SetOptions{ContentType: herd.ContentTypeJSON}); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if _, err := kv.TakeSnapshot(); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if _, err := kv.MultiSet([]herd.KeyValue{{Key: "logged", Value: blob, ContentType: "image/png"}}, 0); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	t.Run("Recovers values byte for byte", func(t *testing.T) {
+		restarted := herd.NewKeyValueStore()
+		if err := restarted.InitLogging(logFile, opts, herd.SnapshotOptions{}); err != nil {
+			t.Fatalf("Unexpected error: %v", err)
+		}
+
+		expected := map[string]herd.ScanItem{
+			"snapshotted": {Value: blob},
+			"doc":         {Value: []byte(`{"a":1}`), ContentType: herd.ContentTypeJSON},
+			"logged":      {Value: blob, ContentType: "image/png"},
+		}
+		for key, want := range expected {
+			item, ok := restarted.GetItem(key)
+			if !ok || !bytes.Equal(item.Value, want.Value) || item.ContentType != want.ContentType {
+				t.Errorf("Expected %s to be recovered with content type %q, got %q (found %v)", key, want.ContentType, item.ContentType, ok)
+			}
+		}
+	})
+}
+
+func TestEncryption(t *testing.T) {
+	dir := t.TempDir()
+	logFile := filepath.Join(dir, "transaction.log")
+	oldKey := base64.StdEncoding.EncodeToString(bytes.Repeat([]byte{1}, 32))
+	newKey := base64.StdEncoding.EncodeToString(bytes.Repeat([]byte{2}, 32))
+
+	oldKeys, err := herd.ParseKeyring("old:" + oldKey)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	rotatedKeys, err := herd.ParseKeyring("new:" + newKey + ",old:" + oldKey)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	kv := herd.NewKeyValueStore()
+	opts := herd.LoggerOptions{Durability: herd.DurabilityAlways, Keys: oldKeys}
+	if err = kv.InitLogging(logFile, opts, herd.SnapshotOptions{Retain: 10}); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	kv.Set("snapshotted", json.RawMessage(`"first secret"`))
+	if _, err = kv.TakeSnapshot(); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	kv.Set("logged", json.RawMessage(`"second secret"`))
+
+	t.Run("Writes no plaintext", func(t *testing.T) {
+		files, _ := os.ReadDir(dir)
+		for _, file := range files {
+			data, _ := os.ReadFile(filepath.Join(dir, file.Name()))
+			if bytes.Contains(data, []byte("secret")) {
+				t.Errorf("Expected %s to be encrypted", file.Name())
+			}
+		}
+	})
+
+	t.Run("Refuses to drop a key that is still used", func(t *testing.T) {
+		newOnly, _ := herd.ParseKeyring("new:" + newKey)
+		if err := kv.RotateKeys(newOnly); !errors.Is(err, herd.ErrUnknownKey) {
+			t.Errorf("Expected ErrUnknownKey, got %v", err)
+		}
+	})
+
+	t.Run("Rotates to a new key", func(t *testing.T) {
+		if err := kv.RotateKeys(rotatedKeys); err != nil {
+			t.Fatalf("Unexpected error: %v", err)
+		}
+		kv.Set("rotated", json.RawMessage(`"third secret"`))
+
+		info, err := kv.TakeSnapshot()
+		if err != nil {
+			t.Fatalf("Unexpected error: %v", err)
+		}
+		if info.KeyID != "new" {
+			t.Errorf("Expected the snapshot to be encrypted with the new key, got %q", info.KeyID)
+		}
+	})
+
+	t.Run("Reads older files with the retired key", func(t *testing.T) {
+		restarted := herd.NewKeyValueStore()
+		rotatedOpts := herd.LoggerOptions{Durability: herd.DurabilityAlways, Keys: rotatedKeys}
+		if err := restarted.InitLogging(logFile, rotatedOpts, herd.SnapshotOptions{Retain: 10}); err != nil {
+			t.Fatalf("Unexpected error: %v", err)
+		}
+
+		for _, key := range []string{"snapshotted", "logged", "rotated"} {
+			if _, ok := restarted.Get(key); !ok {
+				t.Errorf("Expected %s to be recovered", key)
+			}
+		}
+	})
+
+	t.Run("Fails without the key", func(t *testing.T) {
+		restarted := herd.NewKeyValueStore()
+		err := restarted.InitLogging(logFile, herd.LoggerOptions{Keys: oldKeys}, herd.SnapshotOptions{Retain: 10})
+		if !errors.Is(err, herd.ErrUnknownKey) {
+			t.Errorf("Expected ErrUnknownKey, got %v", err)
+		}
+	})
+}
+
+func TestDocuments(t *testing.T) {
+	logFile := filepath.Join(t.TempDir(), "transaction.log")
+	opts := herd.LoggerOptions{Durability: herd.DurabilityAlways}
+	jsonValue := herd.SetOptions{ContentType: herd.ContentTypeJSON}
+
+	kv := herd.NewKeyValueStore()
+	if err := kv.InitLogging(logFile, opts, herd.SnapshotOptions{}); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	doc := `{"user":{"name":"ada","id":7},"tags":["a","b"]}`
+	if _, err := kv.SetWithOptions("doc", []byte(doc), jsonValue); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	t.Run("GetPath", func(t *testing.T) {
+		for path, want := range map[string]string{
+			"/user/name":     `"ada"`,
+			"$.user.id":      `7`,
+			"$['tags'][1]":   `"b"`,
+			"":               `{"tags":["a","b"],"user":{"id":7,"name":"ada"}}`,
+			"/tags":          `["a","b"]`,
+			`$["user"].name`: `"ada"`,
+		} {
+			value, _, err := kv.GetPath("doc", path)
+			if err != nil {
+				t.Errorf("Unexpected error for %q: %v", path, err)
+				continue
+			}
+			if string(value) != want {
+				t.Errorf("Expected %s at %q, got %s", want, path, value)
+			}
+		}
+
+		if _, _, err := kv.GetPath("doc", "/user/missing"); !errors.Is(err, herd.ErrPathNotFound) {
+			t.Errorf("Expected ErrPathNotFound, got %v", err)
+		}
+		if _, _, err := kv.GetPath("doc", "user"); !errors.Is(err, herd.ErrInvalidArgument) {
+			t.Errorf("Expected ErrInvalidArgument for an invalid path, got %v", err)
+		}
+	})
+
+	t.Run("Patch", func(t *testing.T) {
+		patch := `[
+			{"op":"add","path":"/tags/-","value":"c"},
+			{"op":"replace","path":"/user/name","value":"grace"},
+			{"op":"copy","from":"/user/id","path":"/id"},
+			{"op":"move","from":"/user/id","path":"/user/number"},
+			{"op":"remove","path":"/tags/0"},
+			{"op":"test","path":"/id","value":7.0}
+		]`
+		item, err := kv.Patch("doc", []byte(patch))
+		if err != nil {
+			t.Fatalf("Unexpected error: %v", err)
+		}
+		want := `{"id":7,"tags":["b","c"],"user":{"name":"grace","number":7}}`
+		if string(item.Value) != want || item.ContentType != herd.ContentTypeJSON {
+			t.Errorf("Expected %s, got %s (%q)", want, item.Value, item.ContentType)
+		}
+
+		_, err = kv.Patch("doc", []byte(`[{"op":"remove","path":"/id"},{"op":"test","path":"/user/name","value":"ada"}]`))
+		if !errors.Is(err, herd.ErrPatchTestFailed) {
+			t.Errorf("Expected ErrPatchTestFailed, got %v", err)
+		}
+		if current, _ := kv.GetItem("doc"); string(current.Value) != want || current.Version != item.Version {
+			t.Errorf("Expected a failed patch to leave the value unchanged, got %s", current.Value)
+		}
+
+		if _, err = kv.Patch("missing", []byte(`[]`)); !errors.Is(err, herd.ErrKeyNotFound) {
+			t.Errorf("Expected ErrKeyNotFound, got %v", err)
+		}
+		if _, err = kv.Patch("doc", []byte(`[{"op":"jump","path":"/id"}]`)); !errors.Is(err, herd.ErrInvalidArgument) {
+			t.Errorf("Expected ErrInvalidArgument for an unknown operation, got %v", err)
+		}
+	})
+
+	t.Run("Merge", func(t *testing.T) {
+		item, err := kv.Merge("doc", []byte(`{"id":null,"user":{"name":"ada","admin":true}}`))
+		if err != nil {
+			t.Fatalf("Unexpected error: %v", err)
+		}
+		want := `{"tags":["b","c"],"user":{"admin":true,"name":"ada","number":7}}`
+		if string(item.Value) != want {
+			t.Errorf("Expected %s, got %s", want, item.Value)
+		}
+
+		created, err := kv.Merge("created", []byte(`{"a":{"b":1,"c":null}}`))
+		if err != nil {
+			t.Fatalf("Unexpected error: %v", err)
+		}
+		if string(created.Value) != `{"a":{"b":1}}` || created.ContentType != herd.ContentTypeJSON {
+			t.Errorf("Expected a created JSON value, got %s (%q)", created.Value, created.ContentType)
+		}
+	})
+
+	t.Run("Rejects values not declared as JSON", func(t *testing.T) {
+		if err := kv.Set("raw", []byte(`{"a":1}`)); err != nil {
+			t.Fatalf("Unexpected error: %v", err)
+		}
+		if _, _, err := kv.GetPath("raw", "/a"); !errors.Is(err, herd.ErrNotJSON) {
+			t.Errorf("Expected ErrNotJSON from GetPath, got %v", err)
+		}
+		if _, err := kv.Patch("raw", []byte(`[]`)); !errors.Is(err, herd.ErrNotJSON) {
+			t.Errorf("Expected ErrNotJSON from Patch, got %v", err)
+		}
+		if _, err := kv.Merge("raw", []byte(`{}`)); !errors.Is(err, herd.ErrNotJSON) {
+			t.Errorf("Expected ErrNotJSON from Merge, got %v", err)
+		}
+	})
+
+	t.Run("Recovers the resulting values", func(t *testing.T) {
+		restarted := herd.NewKeyValueStore()
+		if err := restarted.InitLogging(logFile, opts, herd.SnapshotOptions{}); err != nil {
+			t.Fatalf("Unexpected error: %v", err)
+		}
+
+		for _, key := range []string{"doc", "created"} {
+			want, _ := kv.GetItem(key)
+			got, ok := restarted.GetItem(key)
+			if !ok || !bytes.Equal(got.Value, want.Value) || got.ContentType != want.ContentType {
+				t.Errorf("Expected %s to be recovered as %s, got %s", key, want.Value, got.Value)
+			}
+		}
+	})
+}
+
+func TestIndexes(t *testing.T) {
+	logFile := filepath.Join(t.TempDir(), "transaction.log")
+	opts := herd.LoggerOptions{Durability: herd.DurabilityAlways}
+	jsonValue := herd.SetOptions{ContentType: herd.ContentTypeJSON}
+	index := herd.IndexDefinition{Name: "age", Path: "$.age"}
+
+	keysOf := func(result herd.ScanResult) []string {
+		keys := make([]string, len(result.Items))
+		for i, item := range result.Items {
+			keys[i] = item.Key
+		}
+		return keys
+	}
+
+	kv := herd.NewKeyValueStore()
+	if err := kv.CreateIndex(index); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if err := kv.InitLogging(logFile, opts, herd.SnapshotOptions{}); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	for key, doc := range map[string]string{
+		"ada":     `{"age":36}`,
+		"alan":    `{"age":41}`,
+		"grace":   `{"age":36.0}`,
+		"edsger":  `{"age":"unknown"}`,
+		"barbara": `{"name":"barbara"}`,
+	} {
+		if _, err := kv.SetWithOptions(key, []byte(doc), jsonValue); err != nil {
+			t.Fatalf("Unexpected error: %v", err)
+		}
+	}
+	if err := kv.Set("raw", []byte(`{"age":36}`)); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	t.Run("Equality and range queries", func(t *testing.T) {
+		tests := []struct {
+			query    herd.IndexQuery
+			expected []string
+		}{
+			{herd.IndexQuery{Index: "age", Equal: []byte(`36`)}, []string{"ada", "grace"}},
+			{herd.IndexQuery{Index: "age", Equal: []byte(`"unknown"`)}, []string{"edsger"}},
+			{herd.IndexQuery{Index: "age", Start: []byte(`37`)}, []string{"alan"}},
+			{herd.IndexQuery{Index: "age", End: []byte(`41`)}, []string{"ada", "grace"}},
+			{herd.IndexQuery{Index: "age"}, []string{"ada", "grace", "alan", "edsger"}},
+		}
+		for _, test := range tests {
+			result, err := kv.QueryIndex(test.query)
+			if err != nil {
+				t.Fatalf("Unexpected error: %v", err)
+			}
+			if keys := keysOf(result); !slices.Equal(keys, test.expected) {
+				t.Errorf("Expected %v, got %v", test.expected, keys)
+			}
+		}
+	})
+
+	t.Run("Pages through the results", func(t *testing.T) {
+		var keys []string
+		query := herd.IndexQuery{Index: "age", Limit: 1}
+		for {
+			result, err := kv.QueryIndex(query)
+			if err != nil {
+				t.Fatalf("Unexpected error: %v", err)
+			}
+			keys = append(keys, keysOf(result)...)
+			if result.ContinuationToken == "" {
+				break
+			}
+			query.ContinuationToken = result.ContinuationToken
+		}
+
+		expected := []string{"ada", "grace", "alan", "edsger"}
+		if !slices.Equal(keys, expected) {
+			t.Errorf("Expected %v, got %v", expected, keys)
+		}
+	})
+
+	t.Run("Rejects invalid queries", func(t *testing.T) {
+		if _, err := kv.QueryIndex(herd.IndexQuery{Index: "name"}); !errors.Is(err, herd.ErrIndexNotFound) {
+			t.Errorf("Expected ErrIndexNotFound, got %v", err)
+		}
+		for _, query := range []herd.IndexQuery{
+			{Index: "age", Equal: []byte(`{}`)},
+			{Index: "age", Start: []byte(`1`), End: []byte(`"a"`)},
+			{Index: "age", Equal: []byte(`1`), End: []byte(`2`)},
+			{Index: "age", ContinuationToken: "?"},
+		} {
+			if _, err := kv.QueryIndex(query); !errors.Is(err, herd.ErrInvalidArgument) {
+				t.Errorf("Expected ErrInvalidArgument for %+v, got %v", query, err)
+			}
+		}
+	})
+
+	t.Run("Stays in sync with writes", func(t *testing.T) {
+		if _, err := kv.SetWithOptions("alan", []byte(`{"age":36}`), jsonValue); err != nil {
+			t.Fatalf("Unexpected error: %v", err)
+		}
+		kv.Delete("grace")
+		if _, err := kv.Merge("barbara", []byte(`{"age":36}`)); err != nil {
+			t.Fatalf("Unexpected error: %v", err)
+		}
+
+		result, err := kv.QueryIndex(herd.IndexQuery{Index: "age", Equal: []byte(`36`)})
+		if err != nil {
+			t.Fatalf("Unexpected error: %v", err)
+		}
+		expected := []string{"ada", "alan", "barbara"}
+		if keys := keysOf(result); !slices.Equal(keys, expected) {
+			t.Errorf("Expected %v, got %v", expected, keys)
+		}
+	})
+
+	if _, err := kv.TakeSnapshot(); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	kv.Delete("ada")
+
+	t.Run("Rebuilds the index on recovery", func(t *testing.T) {
+		restarted := herd.NewKeyValueStore()
+		if err := restarted.CreateIndex(index); err != nil {
+			t.Fatalf("Unexpected error: %v", err)
+		}
+		if err := restarted.InitLogging(logFile, opts, herd.SnapshotOptions{}); err != nil {
+			t.Fatalf("Unexpected error: %v", err)
+		}
+
+		result, err := restarted.QueryIndex(herd.IndexQuery{Index: "age", Equal: []byte(`36`)})
+		if err != nil {
+			t.Fatalf("Unexpected error: %v", err)
+		}
+		expected := []string{"alan", "barbara"}
+		if keys := keysOf(result); !slices.Equal(keys, expected) {
+			t.Errorf("Expected %v, got %v", expected, keys)
+		}
+	})
+
+	t.Run("Is cleared by DeleteALL", func(t *testing.T) {
+		if err := kv.DeleteALL(); err != nil {
+			t.Fatalf("Unexpected error: %v", err)
+		}
+		result, err := kv.QueryIndex(herd.IndexQuery{Index: "age"})
+		if err != nil {
+			t.Fatalf("Unexpected error: %v", err)
+		}
+		if len(result.Items) != 0 {
+			t.Errorf("Expected an empty index, got %v", keysOf(result))
+		}
+	})
+}
+
+func TestCollections(t *testing.T) {
+	logFile := filepath.Join(t.TempDir(), "transaction.log")
+	opts := herd.LoggerOptions{Durability: herd.DurabilityAlways}
+
+	kv := herd.NewKeyValueStore()
+	if err := kv.InitLogging(logFile, opts, herd.SnapshotOptions{}); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	t.Run("Lists", func(t *testing.T) {
+		if _, err := kv.ListPush("queue", [][]byte{[]byte("b"), []byte("c")}, false); err != nil {
+			t.Fatalf("Unexpected error: %v", err)
+		}
+		length, err := kv.ListPush("queue", [][]byte{[]byte("a")}, true)
+		if err != nil || length != 3 {
+			t.Fatalf("Expected a length of 3, got %d (%v)", length, err)
+		}
+
+		values, err := kv.ListRange("queue", 0, -1)
+		if err != nil || !slices.EqualFunc(values, [][]byte{[]byte("a"), []byte("b"), []byte("c")}, bytes.Equal) {
+			t.Errorf("Expected [a b c], got %q (%v)", values, err)
+		}
+		if values, _ = kv.ListRange("queue", -2, 10); len(values) != 2 || string(values[0]) != "b" {
+			t.Errorf("Expected [b c], got %q", values)
+		}
+
+		popped, err := kv.ListPop("queue", 2, false)
+		if err != nil || len(popped) != 2 || string(popped[0]) != "c" || string(popped[1]) != "b" {
+			t.Errorf("Expected to pop [c b], got %q (%v)", popped, err)
+		}
+		if popped, _ = kv.ListPop("queue", 5, true); len(popped) != 1 || string(popped[0]) != "a" {
+			t.Errorf("Expected to pop [a], got %q", popped)
+		}
+		if _, ok := kv.GetItem("queue"); ok {
+			t.Error("Expected an emptied list to be deleted")
+		}
+	})
+
+	t.Run("Sets", func(t *testing.T) {
+		added, err := kv.SetAdd("tags", []string{"go", "db", "go"})
+		if err != nil || added != 2 {
+			t.Fatalf("Expected 2 members to be added, got %d (%v)", added, err)
+		}
+		if removed, _ := kv.SetRemove("tags", []string{"db", "missing"}); removed != 1 {
+			t.Errorf("Expected 1 member to be removed, got %d", removed)
+		}
+		if _, err = kv.SetAdd("tags", []string{"kv"}); err != nil {
+			t.Fatalf("Unexpected error: %v", err)
+		}
+
+		members, err := kv.SetMembers("tags")
+		if err != nil || !slices.Equal(members, []string{"go", "kv"}) {
+			t.Errorf("Expected [go kv], got %v (%v)", members, err)
+		}
+	})
+
+	t.Run("Hashes", func(t *testing.T) {
+		added, err := kv.HashSet("user", map[string][]byte{"name": []byte("ada"), "lang": []byte("go")})
+		if err != nil || added != 2 {
+			t.Fatalf("Expected 2 fields to be added, got %d (%v)", added, err)
+		}
+		if added, _ = kv.HashSet("user", map[string][]byte{"name": []byte("grace")}); added != 0 {
+			t.Errorf("Expected an existing field to be updated, got %d added", added)
+		}
+
+		value, found, err := kv.HashGet("user", "name")
+		if err != nil || !found || string(value) != "grace" {
+			t.Errorf("Expected grace, got %q (found %v, %v)", value, found, err)
+		}
+		if _, found, _ = kv.HashGet("user", "missing"); found {
+			t.Error("Expected a missing field not to be found")
+		}
+		if hash, _ := kv.HashGetAll("user"); len(hash) != 2 || string(hash["lang"]) != "go" {
+			t.Errorf("Expected 2 fields, got %q", hash)
+		}
+	})
+
+	t.Run("Sorted sets", func(t *testing.T) {
+		_, err := kv.SortedSetAdd("scores", []herd.ScoredMember{{Member: "ada", Score: 30}, {Member: "alan", Score: 10}, {Member: "grace", Score: 20}})
+		if err != nil {
+			t.Fatalf("Unexpected error: %v", err)
+		}
+		if added, _ := kv.SortedSetAdd("scores", []herd.ScoredMember{{Member: "alan", Score: 40}}); added != 0 {
+			t.Errorf("Expected an existing member to be updated, got %d added", added)
+		}
+
+		members, err := kv.SortedSetRangeByScore("scores", 20, math.Inf(1), 0)
+		expected := []herd.ScoredMember{{Member: "grace", Score: 20}, {Member: "ada", Score: 30}, {Member: "alan", Score: 40}}
+		if err != nil || !slices.Equal(members, expected) {
+			t.Errorf("Expected %v, got %v (%v)", expected, members, err)
+		}
+		if members, _ = kv.SortedSetRangeByScore("scores", 0, 100, 1); len(members) != 1 || members[0].Member != "grace" {
+			t.Errorf("Expected the lowest score to be returned, got %v", members)
+		}
+		if _, err = kv.SortedSetAdd("scores", []herd.ScoredMember{{Member: "nan", Score: math.NaN()}}); !errors.Is(err, herd.ErrInvalidArgument) {
+			t.Errorf("Expected ErrInvalidArgument for a NaN score, got %v", err)
+		}
+	})
+
+	t.Run("Enforces types", func(t *testing.T) {
+		if err := kv.Set("plain", []byte("value")); err != nil {
+			t.Fatalf("Unexpected error: %v", err)
+		}
+		if _, err := kv.ListPush("plain", [][]byte{[]byte("a")}, false); !errors.Is(err, herd.ErrWrongType) {
+			t.Errorf("Expected ErrWrongType, got %v", err)
+		}
+		if _, err := kv.SetMembers("user"); !errors.Is(err, herd.ErrWrongType) {
+			t.Errorf("Expected ErrWrongType, got %v", err)
+		}
+		if _, err := kv.SortedSetAdd("tags", []herd.ScoredMember{{Member: "a"}}); !errors.Is(err, herd.ErrWrongType) {
+			t.Errorf("Expected ErrWrongType, got %v", err)
+		}
+
+		_, err := kv.SetWithOptions("forged", []byte{0xff}, herd.SetOptions{ContentType: herd.ContentTypeList})
+		if !errors.Is(err, herd.ErrInvalidArgument) {
+			t.Errorf("Expected ErrInvalidArgument for a malformed list, got %v", err)
+		}
+	})
+
+	if _, err := kv.TakeSnapshot(); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if _, err := kv.ListPush("queue", [][]byte{[]byte("logged")}, false); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	t.Run("Recovers from the snapshot and the log", func(t *testing.T) {
+		restarted := herd.NewKeyValueStore()
+		if err := restarted.InitLogging(logFile, opts, herd.SnapshotOptions{}); err != nil {
+			t.Fatalf("Unexpected error: %v", err)
+		}
+
+		if values, _ := restarted.ListRange("queue", 0, -1); len(values) != 1 || string(values[0]) != "logged" {
+			t.Errorf("Expected [logged], got %q", values)
+		}
+		if members, _ := restarted.SetMembers("tags"); !slices.Equal(members, []string{"go", "kv"}) {
+			t.Errorf("Expected [go kv], got %v", members)
+		}
+		if value, _, _ := restarted.HashGet("user", "name"); string(value) != "grace" {
+			t.Errorf("Expected grace, got %q", value)
+		}
+		if members, _ := restarted.SortedSetRangeByScore("scores", math.Inf(-1), math.Inf(1), 0); len(members) != 3 {
+			t.Errorf("Expected 3 members, got %v", members)
+		}
+	})
+}
+
+func TestCounters(t *testing.T) {
+	logFile := filepath.Join(t.TempDir(), "transaction.log")
+	opts := herd.LoggerOptions{Durability: herd.DurabilityAlways}
+
+	kv := herd.NewKeyValueStore()
+	if err := kv.InitLogging(logFile, opts, herd.SnapshotOptions{}); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	t.Run("Concurrent increments", func(t *testing.T) {
+		var wg sync.WaitGroup
+		for range 50 {
+			wg.Add(1)
+			go func() {
+				defer wg.Done()
+				if _, _, err := kv.Increment("hits", herd.IntNumber(2), herd.IncrementOptions{}); err != nil {
+					t.Errorf("Unexpected error: %v", err)
+				}
+			}()
+		}
+		wg.Wait()
+
+		result, item, err := kv.Decrement("hits", herd.IntNumber(1), herd.IncrementOptions{})
+		if err != nil || result != herd.IntNumber(99) || string(item.Value) != "99" {
+			t.Errorf("Expected 99, got %v (%q, %v)", result, item.Value, err)
+		}
+	})
+
+	t.Run("Initial value and TTL", func(t *testing.T) {
+		incrementOpts := herd.IncrementOptions{Initial: herd.IntNumber(10), TTL: 50 * time.Millisecond}
+		result, _, err := kv.Increment("window", herd.IntNumber(1), incrementOpts)
+		if err != nil || result != herd.IntNumber(11) {
+			t.Fatalf("Expected 11, got %v (%v)", result, err)
+		}
+		if result, _, _ = kv.Increment("window", herd.IntNumber(1), incrementOpts); result != herd.IntNumber(12) {
+			t.Errorf("Expected the initial value to apply only to a new key, got %v", result)
+		}
+
+		time.Sleep(60 * time.Millisecond)
+		if _, ok := kv.Get("window"); ok {
+			t.Error("Expected the counter to expire with the TTL set when it was created")
+		}
+	})
+
+	t.Run("Float deltas", func(t *testing.T) {
+		if err := kv.Set("ratio", []byte("1")); err != nil {
+			t.Fatalf("Unexpected error: %v", err)
+		}
+		result, item, err := kv.Increment("ratio", herd.FloatNumber(0.5), herd.IncrementOptions{})
+		if err != nil || result != herd.FloatNumber(1.5) || string(item.Value) != "1.5" {
+			t.Errorf("Expected 1.5, got %v (%q, %v)", result, item.Value, err)
+		}
+
+		if _, _, err = kv.Increment("ratio", herd.IntNumber(1), herd.IncrementOptions{}); !errors.Is(err, herd.ErrNotNumber) {
+			t.Errorf("Expected ErrNotNumber for an integer delta on a float, got %v", err)
+		}
+	})
+
+	t.Run("Rejects values that aren't numbers", func(t *testing.T) {
+		if err := kv.Set("name", []byte("ada")); err != nil {
+			t.Fatalf("Unexpected error: %v", err)
+		}
+		if _, _, err := kv.Increment("name", herd.IntNumber(1), herd.IncrementOptions{}); !errors.Is(err, herd.ErrNotNumber) {
+			t.Errorf("Expected ErrNotNumber, got %v", err)
+		}
+
+		if err := kv.Set("max", []byte(strconv.FormatInt(math.MaxInt64, 10))); err != nil {
+			t.Fatalf("Unexpected error: %v", err)
+		}
+		if _, _, err := kv.Increment("max", herd.IntNumber(1), herd.IncrementOptions{}); !errors.Is(err, herd.ErrOverflow) {
+			t.Errorf("Expected ErrOverflow, got %v", err)
+		}
+
+		if _, err := kv.ListPush("list", [][]byte{[]byte("1")}, false); err != nil {
+			t.Fatalf("Unexpected error: %v", err)
+		}
+		if _, _, err := kv.Increment("list", herd.FloatNumber(1), herd.IncrementOptions{}); !errors.Is(err, herd.ErrWrongType) {
+			t.Errorf("Expected ErrWrongType, got %v", err)
+		}
+	})
+
+	t.Run("Replays the resulting values", func(t *testing.T) {
+		restarted := herd.NewKeyValueStore()
+		if err := restarted.InitLogging(logFile, opts, herd.SnapshotOptions{}); err != nil {
+			t.Fatalf("Unexpected error: %v", err)
+		}
+
+		for key, want := range map[string]string{"hits": "99", "ratio": "1.5"} {
+			if value, ok := restarted.Get(key); !ok || string(value) != want {
+				t.Errorf("Expected %s to be %s, got %q", key, want, value)
+			}
+		}
+	})
+}
+
+func TestSnapshotDuringWrites(t *testing.T) {
+	logFile := filepath.Join(t.TempDir(), "transaction.log")
+	opts := herd.LoggerOptions{Durability: herd.DurabilityNo}
+
+	kv := herd.NewKeyValueStore()
+	if err := kv.InitLogging(logFile, opts, herd.SnapshotOptions{}); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	const writes = 2000
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		for i := range writes {
+			kv.Set(fmt.Sprintf("key%d", i), json.RawMessage(strconv.Itoa(i)))
+		}
+	}()
+
+	for range 5 {
+		if _, err := kv.TakeSnapshot(); err != nil {
+			t.Fatalf("Unexpected error: %v", err)
+		}
+	}
+	<-done
+
+	// Snapshots flush the log, so the restored store doesn't depend on unwritten records
+	kv.Set("after", json.RawMessage(`true`))
+	kv.Delete("key0")
+	if _, err := kv.TakeSnapshot(); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	restored := herd.NewKeyValueStore()
+	if err := restored.InitLogging(logFile, opts, herd.SnapshotOptions{}); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	if keys := restored.GetKeys(); len(keys) != writes {
+		t.Errorf("Expected %d keys, got %d", writes, len(keys))
+	}
+
+	if value, ok := restored.Get(fmt.Sprintf("key%d", writes-1)); !ok || string(value) != strconv.Itoa(writes-1) {
+		t.Errorf("Expected the last write to be restored, got %s", value)
+	}
+}
+
+func TestDurability(t *testing.T) {
+	if _, err := herd.ParseDurabilityMode("sometimes"); err == nil {
+		t.Errorf("Expected an error for an unknown durability mode")
+	}
+
+	for name, opts := range map[string]herd.LoggerOptions{
+		"always":         {Durability: herd.DurabilityAlways},
+		"everysec":       {Durability: herd.DurabilityEverySec},
+		"no":             {Durability: herd.DurabilityNo},
+		"small batches":  {BatchSize: 3, QueueSize: 2, FlushInterval: time.Microsecond},
+		"large interval": {BatchSize: 1000, FlushInterval: time.Hour},
+	} {
+		t.Run(name, func(t *testing.T) {
+			logFile := filepath.Join(t.TempDir(), "transaction.log")
+			logger, err := herd.NewLogger(logFile, opts)
+			if err != nil {
+				t.Fatalf("Unexpected error: %v", err)
+			}
+
+			// Alternate writes to the same key, which only replay correctly in order
+			var written []herd.LogEntry
+			for i := range 100 {
+				operation := "SET"
+				if i%2 == 1 {
+					operation = "DELETE"
+				}
+				written = append(written, herd.LogEntry{Timestamp: time.Now(), Operation: operation, Key: "key", Revision: uint64(i + 1)})
+			}
+			writeLogs(t, logger, written...)
+
+			if err = logger.Close(); err != nil {
+				t.Fatalf("Unexpected error: %v", err)
+			}
+
+			reopened, err := herd.NewLogger(logFile, opts)
+			if err != nil {
+				t.Fatalf("Unexpected error: %v", err)
+			}
+			defer reopened.Close()
+
+			entries, err := reopened.ReadLogs(herd.LogPosition{})
+			if err != nil || len(entries) != len(written) {
+				t.Fatalf("Expected %d entries, got %d: %v", len(written), len(entries), err)
+			}
+
+			for i, entry := range entries {
+				if entry.Operation != written[i].Operation || entry.Revision != written[i].Revision {
+					t.Fatalf("Entry %d out of order: %+v", i, entry)
+				}
+			}
+		})
+	}
+}
+
+func TestLogBackpressure(t *testing.T) {
+	// Every record is written on its own and starts a new segment, so the writer falls behind
+	logFile := filepath.Join(t.TempDir(), "transaction.log")
+	opts := herd.LoggerOptions{Durability: herd.DurabilityNo, QueueSize: 1, BatchSize: 1, SegmentSize: 1}
+
+	kv := herd.NewKeyValueStore()
+	if err := kv.InitLogging(logFile, opts, herd.SnapshotOptions{}); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	var err error
+	var key string
+	for i := 0; i < 10000 && err == nil; i++ {
+		key = "key" + strconv.Itoa(i)
+		err = kv.Set(key, json.RawMessage(`1`))
+	}
+
+	if !errors.Is(err, herd.ErrLogBackpressure) {
+		t.Fatalf("Expected ErrLogBackpressure once the queue is full, got %v", err)
+	}
+	if _, ok := kv.Get(key); ok {
+		t.Errorf("Expected the refused write of %s not to be applied", key)
+	}
+
+	// Wait for the writer to catch up before the log directory is removed
+	if _, err = kv.TakeSnapshot(); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+}
+
+func writeLogs(t *testing.T, logger *herd.Logger, entries ...herd.LogEntry) {
+	t.Helper()
+
+	for _, entry := range entries {
+		if err := logger.WriteLog(entry); err != nil {
+			t.Fatalf("Unexpected error: %v", err)
+		}
+	}
+}
+
+func appendFile(t *testing.T, name string, data []byte) {
+	t.Helper()
+
+	file, err := os.OpenFile(name, os.O_APPEND|os.O_WRONLY, 0644)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	defer file.Close()
+
+	if _, err = file.Write(data); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+}
@@ -0,0 +1,235 @@
+package keyvaluestore
+
+import (
+	"crypto/cipher"
+	"encoding/binary"
+	"fmt"
+	"hash/crc32"
+	"log"
+	"time"
+)
+
+const (
+	// syncInterval is how often the log is fsynced in DurabilityEverySec mode.
+	syncInterval = time.Second
+
+	// defaultLogQueueSize is how many records can wait for the log writer by default.
+	defaultLogQueueSize = 4096
+	// defaultLogBatchSize is how many records the log writer groups into one write by default.
+	defaultLogBatchSize = 256
+	// defaultLogFlushInterval is how long the log writer waits for a batch to fill by default.
+	defaultLogFlushInterval = 5 * time.Millisecond
+)
+
+// logRecord is an encoded record waiting for the log writer. If done is set, the
+// writer writes and fsyncs everything queued up to and including the record
+// straight away and reports the result on done. If mark is set, the writer writes
+// everything queued before the record and reports the position it reached on mark.
+type logRecord struct {
+	data []byte
+	done chan error
+	mark chan logMark
+}
+
+// logMark is the position in the log reached by a marker record.
+type logMark struct {
+	position LogPosition
+	err      error
+}
+
+// writeLoop is the log writer: the single goroutine that writes to the log file.
+// Records are written in the order they were queued. They are grouped into a
+// single write until the batch holds opts.BatchSize records or opts.FlushInterval
+// has passed, and in DurabilityEverySec mode the file is fsynced every second.
+func (l *Logger) writeLoop() {
+	defer close(l.stopped)
+
+	flushTicker := time.NewTicker(l.opts.FlushInterval)
+	defer flushTicker.Stop()
+
+	var syncTick <-chan time.Time
+	if l.opts.Durability == DurabilityEverySec {
+		syncTicker := time.NewTicker(syncInterval)
+		defer syncTicker.Stop()
+		syncTick = syncTicker.C
+	}
+
+	var (
+		batch   []byte
+		records int
+		dirty   bool // written since the last fsync
+	)
+	commit := func(sync bool) error {
+		if records > 0 {
+			// A failed batch is dropped rather than retried, so it can't be written twice
+			err := l.writeBatch(batch)
+			batch, records, dirty = batch[:0], 0, true
+			if err != nil {
+				return err
+			}
+		}
+
+		if sync && dirty {
+			dirty = false
+			return l.sync()
+		}
+
+		return nil
+	}
+
+	for {
+		select {
+		case record, ok := <-l.queue:
+			if !ok {
+				logWriteErr(commit(true))
+				return
+			}
+
+			batch = append(batch, record.data...)
+			if record.data != nil {
+				records++
+			}
+
+			switch {
+			case record.mark != nil:
+				err := commit(false)
+				record.mark <- logMark{position: l.currentPosition(), err: err}
+			case record.done != nil:
+				dirty = true // fsync even if only earlier writes are pending
+				record.done <- commit(true)
+			case records >= l.opts.BatchSize:
+				logWriteErr(commit(false))
+			}
+		case <-flushTicker.C:
+			logWriteErr(commit(false))
+		case <-syncTick:
+			logWriteErr(commit(true))
+		}
+	}
+}
+
+// enqueue hands data to the log writer. If wait is set, it blocks until the data
+// and every record queued before it have been written and fsynced.
+func (l *Logger) enqueue(data []byte, wait bool) error {
+	record := logRecord{data: data}
+	if wait {
+		record.done = make(chan error, 1)
+	}
+
+	l.queue <- record
+	if !wait {
+		return nil
+	}
+
+	return <-record.done
+}
+
+// markPosition queues a marker and returns a channel that receives the position
+// just past every record queued before it, once they have been written. Queueing
+// the marker is cheap, so it can be done while holding the store lock.
+func (l *Logger) markPosition() <-chan logMark {
+	mark := make(chan logMark, 1)
+	l.queue <- logRecord{mark: mark}
+
+	return mark
+}
+
+// Overloaded reports whether the log writer's queue is full. Writes queued while
+// it is overloaded block until the writer catches up, so callers that can refuse
+// work should do so instead.
+func (l *Logger) Overloaded() bool {
+	return len(l.queue) >= cap(l.queue)
+}
+
+// writeBatch writes a batch of encoded records to the log file with a single write,
+// first moving on to a new segment if the current one is full or was encrypted with
+// a key that has since been rotated out.
+func (l *Logger) writeBatch(batch []byte) error {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	if err := l.discardTornWrite(); err != nil {
+		return err
+	}
+	if err := l.rotateIfNeeded(len(batch)); err != nil {
+		return err
+	}
+
+	if l.segmentKey != "" {
+		aead, err := l.keys.aead(l.segmentKey)
+		if err != nil {
+			return err
+		}
+		if batch, err = sealRecords(batch, aead); err != nil {
+			return err
+		}
+	}
+
+	n, err := l.file.Write(batch)
+	if err != nil {
+		// Part of the batch may have been written, e.g. before the disk filled up. It is
+		// cut off before anything else is written, so no record follows a torn one.
+		l.torn = n > 0
+		logWriteErr(l.discardTornWrite())
+		return fmt.Errorf("failed to write to log file: %w", err)
+	}
+	l.size += int64(n)
+
+	return nil
+}
+
+// discardTornWrite truncates the current segment back to the end of its last complete
+// batch if a write failed part way through it.
+func (l *Logger) discardTornWrite() error {
+	if !l.torn {
+		return nil
+	}
+
+	if err := l.file.Truncate(l.size); err != nil {
+		return fmt.Errorf("failed to discard a partial write to the log file: %w", err)
+	}
+	l.torn = false
+
+	return nil
+}
+
+// sealRecords encrypts the payload of every record in batch with aead and frames
+// the encrypted payloads as checksummed records again.
+func sealRecords(batch []byte, aead cipher.AEAD) ([]byte, error) {
+	sealed := make([]byte, 0, len(batch)+len(batch)/8)
+	for offset := 0; offset < len(batch); {
+		length := int(binary.LittleEndian.Uint32(batch[offset:]))
+		payload := batch[offset+recordHeaderSize : offset+recordHeaderSize+length]
+		offset += recordHeaderSize + length
+
+		ciphertext, err := seal(aead, payload, nil)
+		if err != nil {
+			return nil, err
+		}
+
+		sealed = binary.LittleEndian.AppendUint32(sealed, uint32(len(ciphertext)))
+		sealed = binary.LittleEndian.AppendUint32(sealed, crc32.Checksum(ciphertext, crcTable()))
+		sealed = append(sealed, ciphertext...)
+	}
+
+	return sealed, nil
+}
+
+// sync flushes the log file to stable storage.
+func (l *Logger) sync() error {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	if err := l.file.Sync(); err != nil {
+		return fmt.Errorf("failed to sync log file: %w", err)
+	}
+
+	return nil
+}
+
+// logWriteErr reports an error from the log writer, which has no caller to return it to.
+func logWriteErr(err error) {
+	if err != nil {
+		log.Printf("Error writing transaction log: %v", err)
+	}
+}
@@ -1,153 +1,745 @@
-package keyvaluestore
-
-import (
-	"bufio"
-	"errors"
-	"fmt"
-	"log"
-	"os"
-	"strings"
-	"sync"
-	"time"
-)
-
-// LogEntry represents a log entry.
-type LogEntry struct {
-	Timestamp time.Time `json:"timestamp"`
-	Operation string    `json:"operation"`
-	Key       string    `json:"key"`
-	Value     string    `json:"value"`
-}
-
-// Logger is a simple logger that writes to a file.
-type Logger struct {
-	filename string
-	mu       sync.RWMutex
-}
-
-// NewLogger creates a new logger that writes to the specified file.
-func NewLogger(filename string) (*Logger, error) {
-	// Attempt to open the file to ensure it exists and is accessible
-	file, err := os.OpenFile(filename, os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0644)
-	if err != nil {
-		return nil, fmt.Errorf("failed to open or create log file: %w", err)
-	}
-	file.Close() // Close the file as we don't need to keep it open
-
-	return &Logger{filename: filename}, nil
-}
-
-// WriteLog writes a log entry to the logger's file.
-func (l *Logger) WriteLog(entry LogEntry) {
-	l.mu.Lock()
-	defer l.mu.Unlock()
-
-	// Open the log file for appending
-	file, err := os.OpenFile(l.filename, os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0644)
-	if err != nil {
-		log.Printf("Error opening log file: %v", err)
-		return
-	}
-	defer file.Close()
-
-	// Format the log entry
-	logLine := fmt.Sprintf("[%s] %s - Key: %s, Value: %s\n",
-		entry.Timestamp.Format(time.RFC3339),
-		entry.Operation,
-		entry.Key,
-		entry.Value,
-	)
-
-	if _, writeErr := file.WriteString(logLine); writeErr != nil {
-		log.Printf("Error writing to log file: %v", writeErr)
-	}
-}
-
-// parseLogLine parses a log line into a LogEntry struct.
-func parseLogLine(line string) (LogEntry, error) {
-	const (
-		splitParts        = 2
-		keyValueSeparator = ", "
-	)
-
-	// Split the log line into timestamp and operation/key-value parts
-	parts := strings.SplitN(line, "] ", splitParts)
-	if len(parts) != splitParts {
-		return LogEntry{}, errors.New("invalid log line format")
-	}
-
-	// Parse the timestamp
-	timestamp, err := time.Parse(time.RFC3339, strings.Trim(parts[0], "[]"))
-	if err != nil {
-		return LogEntry{}, err
-	}
-
-	// Parse the operation and key-value parts
-	operationParts := strings.SplitN(parts[1], " - ", splitParts)
-	if len(operationParts) != splitParts {
-		return LogEntry{}, errors.New("invalid log line format (operation)")
-	}
-
-	// Parse the key and value
-	operation := operationParts[0]
-	keyValue := strings.SplitN(operationParts[1], keyValueSeparator, splitParts)
-	if len(keyValue) != splitParts {
-		return LogEntry{}, errors.New("invalid log line format (key/value)")
-	}
-
-	key := strings.TrimPrefix(keyValue[0], "Key: ")
-	value := strings.TrimPrefix(keyValue[1], "Value: ")
-
-	return LogEntry{
-		Timestamp: timestamp,
-		Operation: operation,
-		Key:       key,
-		Value:     value,
-	}, nil
-}
-
-// ReadLogs reads all log entries from the file.
-func (l *Logger) ReadLogs() ([]LogEntry, error) {
-	l.mu.Lock()
-	defer l.mu.Unlock()
-
-	// Open the log file for reading
-	file, err := os.Open(l.filename)
-	if err != nil {
-		return nil, err
-	}
-	defer file.Close()
-
-	// Read each line from the file and parse it into a LogEntry
-	var entries []LogEntry
-	scanner := bufio.NewScanner(file)
-	for scanner.Scan() {
-		line := scanner.Text()
-		entry, parseLogLineErr := parseLogLine(line)
-		if parseLogLineErr != nil {
-			log.Printf("Error parsing log line: %v", parseLogLineErr)
-			continue
-		}
-		entries = append(entries, entry)
-	}
-
-	if scanErr := scanner.Err(); scanErr != nil {
-		return nil, scanErr
-	}
-
-	return entries, nil
-}
-
-// ClearLogs clears the entire transaction log file.
-func (l *Logger) ClearLogs() error {
-	l.mu.Lock()
-	defer l.mu.Unlock()
-
-	// Truncate the file to zero length
-	err := os.Truncate(l.filename, 0)
-	if err != nil {
-		return fmt.Errorf("failed to truncate log file: %w", err)
-	}
-
-	return nil
-}
+package keyvaluestore
+
+import (
+	"bytes"
+	"crypto/cipher"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"hash/crc32"
+	"io"
+	"log"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"time"
+)
+
+// walMagic is written at the start of every binary transaction log so it can
+// be told apart from the older line-based text format.
+const walMagic = "HERDWAL\x01"
+
+// walEncryptedMagic starts a transaction log segment whose records are encrypted.
+// It is followed by the length of the key ID as one byte and the key ID.
+const walEncryptedMagic = "HERDWAL\x02"
+
+// recordHeaderSize is the size of the length and CRC32 that precede every record.
+const recordHeaderSize = 8
+
+// maxRecordSize is the largest record the log writes. A longer length in a record header means it is damaged.
+const maxRecordSize = 64 << 20
+
+// sealOverhead is the room left in a record for the nonce and tag added when it is encrypted.
+const sealOverhead = 64
+
+// maxBatchPartSize is the most bytes of transaction writes logged in one record,
+// leaving room for the fields of the transaction itself.
+const maxBatchPartSize = maxRecordSize - sealOverhead - 64
+
+// LogEntry represents a log entry.
+type LogEntry struct {
+	Timestamp   time.Time
+	Operation   string
+	Key         string
+	Value       []byte
+	ContentType string
+	ExpiresAt   time.Time
+	Revision    uint64
+	Batch       []LogEntry // writes applied atomically by a TXN entry
+}
+
+// LoggerOptions configures how a Logger persists records. Zero values select the defaults.
+type LoggerOptions struct {
+	// Durability selects when records are fsynced. The default is DurabilityEverySec.
+	Durability DurabilityMode
+	// QueueSize is how many records can wait for the log writer before the logger is overloaded.
+	QueueSize int
+	// BatchSize is the most records the log writer groups into a single write.
+	BatchSize int
+	// FlushInterval is the longest a record waits for its batch to fill before it is written.
+	FlushInterval time.Duration
+	// SegmentSize is the size in bytes at which the log moves on to a new segment.
+	SegmentSize int64
+	// SegmentMaxAge moves the log on to a new segment once the current one is this old. Zero disables it.
+	SegmentMaxAge time.Duration
+	// Keys encrypts the log and snapshots at rest if set.
+	Keys *Keyring
+}
+
+// withDefaults returns opts with the default for every unset option.
+func (opts LoggerOptions) withDefaults() LoggerOptions {
+	if opts.Durability == "" {
+		opts.Durability = DurabilityEverySec
+	}
+	if opts.QueueSize <= 0 {
+		opts.QueueSize = defaultLogQueueSize
+	}
+	if opts.BatchSize <= 0 {
+		opts.BatchSize = defaultLogBatchSize
+	}
+	if opts.FlushInterval <= 0 {
+		opts.FlushInterval = defaultLogFlushInterval
+	}
+	if opts.SegmentSize <= 0 {
+		opts.SegmentSize = defaultSegmentSize
+	}
+
+	return opts
+}
+
+// Logger is a simple logger that writes to a file.
+//
+// The log is a sequence of length-prefixed records after a short file header. Each
+// record is a little-endian uint32 payload length, a CRC32 (Castagnoli) of the
+// payload, and the payload itself: the operation code, timestamp, revision, expiry,
+// the raw key and value bytes, so keys and values may hold any bytes, and the
+// content type of the value. A transaction too large for one record
+// is logged as TXNPART records holding parts of its writes, followed by a TXN
+// record with the rest.
+//
+// If a keyring is configured, the payload of every record is encrypted with
+// AES-GCM, and the ID of the key is stored in the header of the segment. The key
+// is checked when a segment is opened and when a batch is written, and the log
+// moves on to a new segment once the active key has been rotated, so every
+// segment is encrypted with a single key.
+//
+// The log is split into numbered segment files next to the configured file name,
+// such as transaction-00000001.log for transaction.log. Records are appended to
+// the last segment until it reaches a size or age threshold, and old segments are
+// removed once no retained snapshot needs them.
+//
+// Records are handed to a single log writer goroutine that keeps the file open,
+// and are written in the order WriteLog is called, so callers that log while
+// holding the store lock get a log in mutation order.
+type Logger struct {
+	dir    string
+	prefix string
+	ext    string
+	opts   LoggerOptions
+
+	mu         sync.RWMutex // guards the current segment and the keys
+	file       *os.File
+	segment    uint64
+	size       int64
+	opened     time.Time
+	headerSize int64
+	torn       bool   // a failed write left part of a batch after size
+	segmentKey string // the ID of the key the current segment is encrypted with, empty if it isn't
+	keys       *Keyring
+
+	queue   chan logRecord
+	stopped chan struct{}
+}
+
+// NewLogger creates a new logger that writes to segments of the specified file.
+// A log written to filename itself before the log was segmented becomes the first
+// segment, and one written in the older text format is converted to the binary format.
+func NewLogger(filename string, opts LoggerOptions) (*Logger, error) {
+	opts = opts.withDefaults()
+	if _, err := ParseDurabilityMode(string(opts.Durability)); err != nil {
+		return nil, err
+	}
+
+	ext := filepath.Ext(filename)
+	l := &Logger{
+		dir:     filepath.Dir(filename),
+		prefix:  strings.TrimSuffix(filepath.Base(filename), ext),
+		ext:     ext,
+		opts:    opts,
+		keys:    opts.Keys,
+		queue:   make(chan logRecord, opts.QueueSize),
+		stopped: make(chan struct{}),
+	}
+
+	segments, err := l.listSegments()
+	if err != nil {
+		return nil, err
+	}
+
+	if _, statErr := os.Stat(filename); statErr == nil {
+		if len(segments) > 0 {
+			return nil, fmt.Errorf("found both the unsegmented log %s and log segments", filename)
+		}
+
+		if prepareErr := prepareLogFile(filename, []byte(walMagic)); prepareErr != nil {
+			return nil, prepareErr
+		}
+		if renameErr := os.Rename(filename, l.segmentPath(1)); renameErr != nil {
+			return nil, fmt.Errorf("failed to rename log file to its first segment: %w", renameErr)
+		}
+		segments = []uint64{1}
+	}
+
+	// Keep appending to the last segment
+	last := uint64(1)
+	if len(segments) > 0 {
+		last = segments[len(segments)-1]
+	}
+	if openErr := l.openSegment(last); openErr != nil {
+		return nil, openErr
+	}
+
+	// Records are only appended to a segment encrypted with the active key
+	if l.segmentKey != l.keys.ActiveKeyID() {
+		l.file.Close()
+		if openErr := l.openSegment(last + 1); openErr != nil {
+			return nil, openErr
+		}
+	}
+
+	go l.writeLoop()
+
+	return l, nil
+}
+
+// prepareLogFile creates the log file with header if it doesn't exist, and converts
+// it to the binary format if it was written in the text format.
+func prepareLogFile(filename string, header []byte) error {
+	// Attempt to open the file to ensure it exists and is accessible
+	file, err := os.OpenFile(filename, os.O_RDWR|os.O_CREATE, 0644)
+	if err != nil {
+		return fmt.Errorf("failed to open or create log file: %w", err)
+	}
+	defer file.Close()
+
+	magic := make([]byte, len(walMagic))
+	n, readErr := io.ReadFull(file, magic)
+	switch {
+	case readErr == nil && (string(magic) == walMagic || string(magic) == walEncryptedMagic):
+		return nil // already a binary log
+	case n == 0 && errors.Is(readErr, io.EOF):
+		if _, writeErr := file.Write(header); writeErr != nil {
+			return fmt.Errorf("failed to write log header: %w", writeErr)
+		}
+		return nil
+	default:
+		return migrateLegacyLog(filename)
+	}
+}
+
+// segmentHeader returns the header of a new log segment encrypted with the key
+// with the given ID, or of a plaintext segment if keyID is empty.
+func segmentHeader(keyID string) []byte {
+	if keyID == "" {
+		return []byte(walMagic)
+	}
+
+	header := append([]byte(walEncryptedMagic), byte(len(keyID)))
+	return append(header, keyID...)
+}
+
+// parseSegmentHeader parses the header at the start of a log segment and returns
+// the ID of the key its records are encrypted with, empty if they aren't, and the
+// size of the header.
+func parseSegmentHeader(data []byte) (string, int, error) {
+	switch {
+	case bytes.HasPrefix(data, []byte(walMagic)):
+		return "", len(walMagic), nil
+	case bytes.HasPrefix(data, []byte(walEncryptedMagic)) && len(data) > len(walEncryptedMagic):
+		size := len(walEncryptedMagic) + 1 + int(data[len(walEncryptedMagic)])
+		if len(data) < size {
+			return "", 0, fmt.Errorf("%w: truncated log header", ErrCorruptLog)
+		}
+		return string(data[len(walEncryptedMagic)+1 : size]), size, nil
+	default:
+		return "", 0, fmt.Errorf("%w: missing log header", ErrCorruptLog)
+	}
+}
+
+// readSegmentHeader reads the header of the log segment at path.
+func readSegmentHeader(path string) (string, int, error) {
+	file, err := os.Open(path)
+	if err != nil {
+		return "", 0, fmt.Errorf("failed to open log segment: %w", err)
+	}
+	defer file.Close()
+
+	header := make([]byte, len(walEncryptedMagic)+1+maxKeyIDLength)
+	n, err := io.ReadFull(file, header)
+	if err != nil && !errors.Is(err, io.ErrUnexpectedEOF) {
+		return "", 0, fmt.Errorf("failed to read log header: %w", err)
+	}
+
+	keyID, size, err := parseSegmentHeader(header[:n])
+	if err != nil {
+		return "", 0, fmt.Errorf("%s: %w", path, err)
+	}
+
+	return keyID, size, nil
+}
+
+// WriteLog queues a log entry for the log writer, blocking while its queue is full.
+//
+// In DurabilityAlways mode WriteLog waits until the log has been fsynced. Otherwise
+// it returns once the entry is queued.
+func (l *Logger) WriteLog(entry LogEntry) error {
+	records, err := encodeRecords(entry)
+	if err != nil {
+		return err
+	}
+
+	// The parts of a transaction are queued together, so only the last one has to be waited for
+	for _, record := range records[:len(records)-1] {
+		if err = l.enqueue(record, false); err != nil {
+			return err
+		}
+	}
+
+	return l.enqueue(records[len(records)-1], l.opts.Durability == DurabilityAlways)
+}
+
+// Close writes and fsyncs any queued records and closes the log file.
+// The logger must not be used after Close.
+func (l *Logger) Close() error {
+	close(l.queue)
+	<-l.stopped
+
+	return l.file.Close()
+}
+
+// ReadLogs reads the log entries recorded after from, in order. The zero position
+// reads the whole log.
+//
+// A record that was only partially written at the end of the last segment is
+// discarded and the segment is truncated after the last complete record. A
+// damaged record anywhere else, or a missing segment, returns an error wrapping
+// ErrCorruptLog, since skipping it would silently replay a different history.
+func (l *Logger) ReadLogs(from LogPosition) ([]LogEntry, error) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	segments, err := l.listSegments()
+	if err != nil {
+		return nil, err
+	}
+
+	var entries []LogEntry
+	expected := from.Segment
+	for i, n := range segments {
+		if n < from.Segment {
+			continue
+		}
+
+		if expected != 0 && n != expected {
+			return nil, fmt.Errorf("%w: log segment %d is missing", ErrCorruptLog, expected)
+		}
+		expected = n + 1
+
+		var offset int64
+		if n == from.Segment {
+			offset = from.Offset
+		}
+
+		segmentEntries, readErr := l.readSegment(n, offset, i == len(segments)-1)
+		if readErr != nil {
+			return nil, readErr
+		}
+		entries = append(entries, segmentEntries...)
+	}
+
+	if from.Segment != 0 && expected == from.Segment {
+		return nil, fmt.Errorf("%w: log segment %d is missing", ErrCorruptLog, from.Segment)
+	}
+
+	return joinBatchParts(entries), nil
+}
+
+// joinBatchParts joins the TXNPART entries of a transaction that was split across
+// records into its TXN entry. Parts that aren't followed by the rest of their
+// transaction were being logged when the server stopped, so they are discarded
+// like a torn record.
+func joinBatchParts(entries []LogEntry) []LogEntry {
+	joined := entries[:0]
+	var parts []LogEntry // the parts of the transaction being joined
+	for _, entry := range entries {
+		if len(parts) > 0 && (parts[0].Revision != entry.Revision || !parts[0].Timestamp.Equal(entry.Timestamp)) {
+			log.Printf("Discarding %d parts of an incomplete transaction at revision %d", len(parts), parts[0].Revision)
+			parts = nil
+		}
+
+		switch entry.Operation {
+		case "TXNPART":
+			parts = append(parts, entry)
+			continue
+		case "TXN":
+			var batch []LogEntry
+			for _, part := range parts {
+				batch = append(batch, part.Batch...)
+			}
+			entry.Batch = append(batch, entry.Batch...)
+			parts = nil
+		}
+		joined = append(joined, entry)
+	}
+
+	if len(parts) > 0 {
+		log.Printf("Discarding %d parts of an incomplete transaction at revision %d", len(parts), parts[0].Revision)
+	}
+
+	return joined
+}
+
+// readSegment reads the entries of segment n from offset, or from just after its
+// header if offset is before it. Only the last segment may end in a torn record.
+// The caller must hold l.mu.
+func (l *Logger) readSegment(n uint64, offset int64, last bool) ([]LogEntry, error) {
+	path := l.segmentPath(n)
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+
+	keyID, headerSize, err := parseSegmentHeader(data)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", path, err)
+	}
+	offset = max(offset, int64(headerSize))
+
+	var aead cipher.AEAD
+	if keyID != "" {
+		if aead, err = l.keys.aead(keyID); err != nil {
+			return nil, fmt.Errorf("%s: %w", path, err)
+		}
+	}
+
+	if offset > int64(len(data)) {
+		return nil, fmt.Errorf("%w: %s: ends before offset %d", ErrCorruptLog, path, offset)
+	}
+
+	entries, valid, err := decodeRecords(data, int(offset), aead)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", path, err)
+	}
+
+	if valid < len(data) {
+		if !last {
+			return nil, fmt.Errorf("%w: %s: incomplete record at offset %d", ErrCorruptLog, path, valid)
+		}
+
+		log.Printf("Discarding %d bytes of incomplete log records at offset %d of %s", len(data)-valid, valid, path)
+		if truncateErr := os.Truncate(path, int64(valid)); truncateErr != nil {
+			return nil, fmt.Errorf("failed to truncate torn log tail: %w", truncateErr)
+		}
+
+		if n == l.segment {
+			l.size = int64(valid)
+		}
+	}
+
+	return entries, nil
+}
+
+// decodeRecords decodes the records in data starting at offset, decrypting their
+// payloads with aead if it is set. It returns the entries and the offset just past
+// the last complete record.
+func decodeRecords(data []byte, offset int, aead cipher.AEAD) ([]LogEntry, int, error) {
+	var entries []LogEntry
+	for offset < len(data) {
+		if len(data)-offset < recordHeaderSize {
+			break // torn header
+		}
+
+		length := int(binary.LittleEndian.Uint32(data[offset:]))
+		checksum := binary.LittleEndian.Uint32(data[offset+4:])
+		end := offset + recordHeaderSize + length
+		if length > len(data)-offset-recordHeaderSize {
+			// Only the final record can run past the end of the log, and only if it was
+			// torn while being written. A damaged length in an earlier record must not
+			// discard the records after it.
+			if length > maxRecordSize || len(data)-offset > recordHeaderSize+maxRecordSize || validRecordAfter(data, offset) {
+				return nil, offset, fmt.Errorf("%w: record at offset %d: length %d exceeds the log", ErrCorruptLog, offset, length)
+			}
+			break // torn payload
+		}
+
+		payload := data[offset+recordHeaderSize : end]
+		var entry LogEntry
+		err := errors.New("checksum mismatch")
+		if crc32.Checksum(payload, crcTable()) == checksum {
+			err = nil
+			if aead != nil {
+				payload, err = unseal(aead, payload, nil)
+			}
+			if err == nil {
+				entry, err = decodePayload(payload)
+			}
+		}
+
+		if err != nil {
+			if end == len(data) {
+				break // the final record was torn while being written
+			}
+			return nil, offset, fmt.Errorf("%w: record at offset %d: %w", ErrCorruptLog, offset, err)
+		}
+
+		entries = append(entries, entry)
+		offset = end
+	}
+
+	return entries, offset, nil
+}
+
+// validRecordAfter reports whether a record with a valid checksum starts anywhere after
+// the record header at offset, which means that header isn't the torn end of the log.
+func validRecordAfter(data []byte, offset int) bool {
+	for p := offset + 1; len(data)-p > recordHeaderSize; p++ {
+		length := int(binary.LittleEndian.Uint32(data[p:]))
+		if length == 0 || length > len(data)-p-recordHeaderSize {
+			continue
+		}
+		payload := data[p+recordHeaderSize : p+recordHeaderSize+length]
+		if crc32.Checksum(payload, crcTable()) == binary.LittleEndian.Uint32(data[p+4:]) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// encodeRecords encodes entry as log records. A transaction whose writes don't fit in
+// one record is split into TXNPART records holding consecutive parts of its writes,
+// followed by a TXN record with the rest, all sharing its timestamp and revision.
+func encodeRecords(entry LogEntry) ([][]byte, error) {
+	if entry.Operation != "TXN" {
+		record, err := encodeRecord(entry)
+		if err != nil {
+			return nil, err
+		}
+		return [][]byte{record}, nil
+	}
+
+	// The most bytes a write takes in a record besides its key, value and content type
+	const writeOverhead = 8 * binary.MaxVarintLen64
+
+	var records [][]byte
+	part := entry
+	part.Batch = nil
+	size := 0
+	for _, write := range entry.Batch {
+		writeSize := len(write.Key) + len(write.Value) + len(write.ContentType) + writeOverhead
+		if len(part.Batch) > 0 && size+writeSize > maxBatchPartSize {
+			part.Operation = "TXNPART"
+			record, encodeErr := encodeRecord(part)
+			if encodeErr != nil {
+				return nil, encodeErr
+			}
+			records = append(records, record)
+			part.Batch, size = nil, 0
+		}
+		part.Batch = append(part.Batch, write)
+		size += writeSize
+	}
+
+	part.Operation = "TXN"
+	record, err := encodeRecord(part)
+	if err != nil {
+		return nil, err
+	}
+
+	return append(records, record), nil
+}
+
+// encodeRecord encodes entry as a length-prefixed, checksummed log record.
+func encodeRecord(entry LogEntry) ([]byte, error) {
+	record := make([]byte, recordHeaderSize, recordHeaderSize+len(entry.Key)+len(entry.Value)+32)
+	record, err := appendPayload(record, entry)
+	if err != nil {
+		return nil, err
+	}
+
+	payload := record[recordHeaderSize:]
+	if len(payload) > maxRecordSize-sealOverhead {
+		return nil, fmt.Errorf("%w: log record of %d bytes exceeds the limit of %d bytes",
+			ErrInvalidArgument, len(payload), maxRecordSize-sealOverhead)
+	}
+	binary.LittleEndian.PutUint32(record, uint32(len(payload)))
+	binary.LittleEndian.PutUint32(record[4:], crc32.Checksum(payload, crcTable()))
+
+	return record, nil
+}
+
+// appendPayload appends the record payload of entry to buf. The writes of a
+// transaction are appended after it, each prefixed with its own length, and the
+// content type of the value comes last.
+func appendPayload(buf []byte, entry LogEntry) ([]byte, error) {
+	op, ok := opCode(entry.Operation)
+	if !ok {
+		return nil, fmt.Errorf("unknown log operation: %s", entry.Operation)
+	}
+
+	buf = append(buf, op)
+	buf = binary.AppendVarint(buf, entry.Timestamp.UnixNano())
+	buf = binary.AppendUvarint(buf, entry.Revision)
+	buf = binary.AppendVarint(buf, unixNano(entry.ExpiresAt))
+	buf = appendBytes(buf, []byte(entry.Key))
+	buf = appendBytes(buf, entry.Value)
+
+	buf = binary.AppendUvarint(buf, uint64(len(entry.Batch)))
+	for _, write := range entry.Batch {
+		nested, err := appendPayload(nil, write)
+		if err != nil {
+			return nil, err
+		}
+		buf = appendBytes(buf, nested)
+	}
+
+	buf = appendBytes(buf, []byte(entry.ContentType))
+
+	return buf, nil
+}
+
+// decodePayload decodes a record payload written by appendPayload.
+func decodePayload(payload []byte) (LogEntry, error) {
+	d := logDecoder{buf: payload}
+	operation, ok := opName(d.byte())
+	if !ok && d.err == nil {
+		d.err = errors.New("unknown operation code")
+	}
+
+	entry := LogEntry{
+		Operation: operation,
+		Timestamp: time.Unix(0, d.varint()),
+		Revision:  d.uvarint(),
+		ExpiresAt: fromUnixNano(d.varint()),
+		Key:       string(d.bytes()),
+		Value:     d.bytes(),
+	}
+
+	count := d.uvarint()
+	for i := uint64(0); i < count && d.err == nil; i++ {
+		op, err := decodePayload(d.bytes())
+		if err != nil {
+			return LogEntry{}, fmt.Errorf("batch entry %d: %w", i, err)
+		}
+		entry.Batch = append(entry.Batch, op)
+	}
+
+	entry.ContentType = string(d.bytes())
+	if d.err == nil && len(d.buf) != 0 {
+		d.err = errors.New("trailing bytes")
+	}
+
+	return entry, d.err
+}
+
+// logDecoder reads the fields of a record payload, remembering the first error.
+type logDecoder struct {
+	buf []byte
+	err error
+}
+
+func (d *logDecoder) byte() byte {
+	if d.err != nil || len(d.buf) == 0 {
+		d.fail()
+		return 0
+	}
+
+	b := d.buf[0]
+	d.buf = d.buf[1:]
+	return b
+}
+
+func (d *logDecoder) uvarint() uint64 {
+	v, n := binary.Uvarint(d.buf)
+	if d.err != nil || n <= 0 {
+		d.fail()
+		return 0
+	}
+
+	d.buf = d.buf[n:]
+	return v
+}
+
+func (d *logDecoder) varint() int64 {
+	v, n := binary.Varint(d.buf)
+	if d.err != nil || n <= 0 {
+		d.fail()
+		return 0
+	}
+
+	d.buf = d.buf[n:]
+	return v
+}
+
+func (d *logDecoder) bytes() []byte {
+	length := d.uvarint()
+	if d.err != nil || length > uint64(len(d.buf)) {
+		d.fail()
+		return nil
+	}
+
+	b := d.buf[:length:length]
+	d.buf = d.buf[length:]
+	return b
+}
+
+func (d *logDecoder) fail() {
+	if d.err == nil {
+		d.err = io.ErrUnexpectedEOF
+	}
+}
+
+// appendBytes appends b to buf prefixed with its length.
+func appendBytes(buf []byte, b []byte) []byte {
+	buf = binary.AppendUvarint(buf, uint64(len(b)))
+	return append(buf, b...)
+}
+
+// crcTable returns the CRC32 table used to checksum log records.
+func crcTable() *crc32.Table {
+	return crc32.MakeTable(crc32.Castagnoli)
+}
+
+// unixNano returns t in nanoseconds since the epoch, or 0 for the zero time.
+func unixNano(t time.Time) int64 {
+	if t.IsZero() {
+		return 0
+	}
+
+	return t.UnixNano()
+}
+
+// fromUnixNano is the inverse of unixNano.
+func fromUnixNano(ns int64) time.Time {
+	if ns == 0 {
+		return time.Time{}
+	}
+
+	return time.Unix(0, ns)
+}
+
+// opCode returns the code an operation is stored as in the log.
+func opCode(operation string) (byte, bool) {
+	switch operation {
+	case "SET":
+		return 1, true
+	case "DELETE":
+		return 2, true
+	case "EXPIRE":
+		return 3, true
+	case "EVICT":
+		return 4, true
+	case "DELETEALL":
+		return 5, true
+	case "TXN":
+		return 6, true
+	case "TXNPART":
+		return 7, true
+	default:
+		return 0, false
+	}
+}
+
+// opName is the inverse of opCode.
+func opName(code byte) (string, bool) {
+	for _, operation := range []string{
+		"SET", "DELETE", "EXPIRE", "EVICT", "DELETEALL", "TXN", "TXNPART",
+	} {
+		if c, _ := opCode(operation); c == code {
+			return operation, true
+		}
+	}
+
+	return "", false
+}
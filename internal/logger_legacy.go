@@ -0,0 +1,100 @@
+package keyvaluestore
+
+import (
+	"bufio"
+	"bytes"
+	"errors"
+	"fmt"
+	"io"
+	"log"
+	"os"
+	"strings"
+	"time"
+)
+
+// migrateLegacyLog rewrites a transaction log in the text format as a binary log.
+// The binary log is written next to it, fsynced and renamed over it once complete,
+// so a crash during the migration leaves the text log in place.
+func migrateLegacyLog(filename string) error {
+	data, err := os.ReadFile(filename)
+	if err != nil {
+		return fmt.Errorf("failed to read legacy log file: %w", err)
+	}
+
+	// Read each line from the file and parse it into a LogEntry. A line that can't be
+	// converted fails the migration, which leaves the text log in place.
+	converted := []byte(walMagic)
+	scanner := bufio.NewScanner(bytes.NewReader(data))
+	for line := 1; scanner.Scan(); line++ {
+		entry, parseLogLineErr := parseLogLine(scanner.Text())
+		if parseLogLineErr != nil {
+			return fmt.Errorf("%w: %s line %d: %w", ErrCorruptLog, filename, line, parseLogLineErr)
+		}
+
+		// Reads were logged too, but they don't change the store
+		switch entry.Operation {
+		case "GET", "GETALL", "GETKEYS", "GETVALUES":
+			continue
+		}
+
+		record, encodeErr := encodeRecord(entry)
+		if encodeErr != nil {
+			return fmt.Errorf("%w: %s line %d: %w", ErrCorruptLog, filename, line, encodeErr)
+		}
+		converted = append(converted, record...)
+	}
+
+	if scanErr := scanner.Err(); scanErr != nil {
+		return fmt.Errorf("failed to read legacy log file: %w", scanErr)
+	}
+
+	writeErr := writeFileAtomic(filename, 0644, func(w io.Writer) error {
+		_, convertedErr := w.Write(converted)
+		return convertedErr
+	})
+	if writeErr != nil {
+		return fmt.Errorf("failed to replace legacy log file: %w", writeErr)
+	}
+
+	log.Printf("Converted legacy transaction log %s to the binary format", filename)
+	return nil
+}
+
+// parseLogLine parses a log line into a LogEntry struct.
+func parseLogLine(line string) (LogEntry, error) {
+	const (
+		splitParts        = 2
+		keyValueSeparator = ", "
+	)
+
+	// Split the log line into timestamp and operation/key-value parts
+	parts := strings.SplitN(line, "] ", splitParts)
+	if len(parts) != splitParts {
+		return LogEntry{}, errors.New("invalid log line format")
+	}
+
+	// Parse the timestamp
+	timestamp, err := time.Parse(time.RFC3339, strings.Trim(parts[0], "[]"))
+	if err != nil {
+		return LogEntry{}, err
+	}
+
+	// Parse the operation and key-value parts
+	operationParts := strings.SplitN(parts[1], " - ", splitParts)
+	if len(operationParts) != splitParts {
+		return LogEntry{}, errors.New("invalid log line format (operation)")
+	}
+
+	// Parse the key and value
+	keyValue := strings.SplitN(operationParts[1], keyValueSeparator, splitParts)
+	if len(keyValue) != splitParts {
+		return LogEntry{}, errors.New("invalid log line format (key/value)")
+	}
+
+	return LogEntry{
+		Timestamp: timestamp,
+		Operation: operationParts[0],
+		Key:       strings.TrimPrefix(keyValue[0], "Key: "),
+		Value:     []byte(strings.TrimPrefix(keyValue[1], "Value: ")),
+	}, nil
+}
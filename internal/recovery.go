@@ -0,0 +1,134 @@
+package keyvaluestore
+
+import (
+	"fmt"
+	"log"
+	"time"
+)
+
+// RecoveryTarget is a point in the history of the store to recover it to. Changes
+// made after Time or at a revision beyond Revision are not replayed. A zero field
+// sets no limit, so the zero RecoveryTarget recovers every change.
+type RecoveryTarget struct {
+	Time     time.Time
+	Revision uint64
+}
+
+// IsZero reports whether t sets no limit.
+func (t RecoveryTarget) IsZero() bool {
+	return t.Time.IsZero() && t.Revision == 0
+}
+
+// includes reports whether a change made at timestamp and revision is part of the
+// history up to t.
+func (t RecoveryTarget) includes(timestamp time.Time, revision uint64) bool {
+	return (t.Time.IsZero() || !timestamp.After(t.Time)) && (t.Revision == 0 || revision <= t.Revision)
+}
+
+// InitLoggingTo is like InitLogging, but only recovers the changes up to target. The
+// newest snapshot taken before target is loaded and the transaction log is replayed
+// from it up to target. A snapshot of the recovered store is then taken, so the
+// later changes stay out of the store after a restart. New writes continue from
+// the latest revision in the log, so revisions never go backwards.
+func (kv *KeyValueStore) InitLoggingTo(logFile string, opts LoggerOptions, snapshots SnapshotOptions, target RecoveryTarget) error {
+	if err := kv.recoverLog(logFile, opts, snapshots, target); err != nil {
+		return err
+	}
+
+	if !target.IsZero() {
+		if _, err := kv.TakeSnapshot(); err != nil {
+			return fmt.Errorf("failed to take snapshot after recovery: %w", err)
+		}
+	}
+
+	// Start the snapshot scheduler
+	go kv.snapshotScheduler()
+	return nil
+}
+
+// RecoverSnapshot recovers the store in the transaction log in logFile up to target
+// and writes it to a new snapshot, which the server starts from the next time it
+// is started. It is meant to be run while the server is stopped.
+func RecoverSnapshot(logFile string, opts LoggerOptions, snapshots SnapshotOptions, target RecoveryTarget) (SnapshotInfo, error) {
+	kv := NewKeyValueStore()
+	if err := kv.recoverLog(logFile, opts, snapshots, target); err != nil {
+		return SnapshotInfo{}, err
+	}
+	defer kv.logger.Close()
+
+	return kv.TakeSnapshot()
+}
+
+// recoverLog opens the transaction log in logFile and restores the store from the
+// snapshots and the log up to target.
+func (kv *KeyValueStore) recoverLog(logFile string, opts LoggerOptions, snapshots SnapshotOptions, target RecoveryTarget) error {
+	// The logger creates the first log segment if there is none yet
+	logger, loggerErr := NewLogger(logFile, opts)
+	if loggerErr != nil {
+		return loggerErr
+	}
+
+	kv.logger = logger
+	kv.snapshotOpts = snapshots.withDefaults()
+
+	// Load the latest snapshot before the target
+	position, found, snapshotErr := kv.loadSnapshot(target)
+	if snapshotErr != nil {
+		return fmt.Errorf("failed to load latest snapshot: %w", snapshotErr)
+	}
+
+	// Without a snapshot the whole log is replayed, which is only possible if none of it has been removed
+	if !found && !target.IsZero() {
+		first, err := logger.firstSegment()
+		if err != nil {
+			return err
+		}
+		if first > 1 {
+			return fmt.Errorf("%w: no snapshot precedes the recovery target and log segments before %d have been removed", ErrCompacted, first)
+		}
+	}
+
+	// Read and process the log entries recorded after the snapshot
+	entries, readLogsErr := logger.ReadLogs(position)
+	if readLogsErr != nil {
+		return fmt.Errorf("failed to read log entries: %w", readLogsErr)
+	}
+
+	if skipped := kv.replay(entries, target); skipped > 0 {
+		log.Printf("Recovered the store up to the recovery target, skipping %d later changes", skipped)
+	}
+
+	return nil
+}
+
+// replay applies the changes in entries up to target to the store and returns how
+// many changes were left out. The store revision is still moved to the latest
+// revision in entries, so that revisions are never reused.
+func (kv *KeyValueStore) replay(entries []LogEntry, target RecoveryTarget) int {
+	kv.mu.Lock()
+	defer kv.mu.Unlock()
+
+	now := time.Now()
+	latest := kv.revision
+	skipped := 0
+	for _, entry := range entries {
+		// Restore the revision of the mutation, numbering entries from older logs in order
+		if entry.Revision == 0 {
+			entry.Revision = latest + 1
+		}
+		latest = max(latest, entry.Revision)
+
+		// Everything after the first change past the target is left out
+		if skipped > 0 || !target.includes(entry.Timestamp, entry.Revision) {
+			skipped++
+			continue
+		}
+		kv.applyLogEntry(entry, now)
+	}
+	kv.revision = latest
+
+	// Replayed changes predate any watcher, so they are not part of the watch history
+	kv.watch.compact(kv.revision)
+
+	return skipped
+}
@@ -0,0 +1,148 @@
+package keyvaluestore
+
+import (
+	"encoding/base64"
+	"fmt"
+	"time"
+
+	"github.com/google/btree"
+)
+
+const (
+	// defaultScanLimit is how many keys a scan returns when no limit is given.
+	defaultScanLimit = 1000
+
+	// maxScanLimit caps how many keys a single scan can return.
+	maxScanLimit = 10000
+
+	// indexDegree is the degree of the B-tree that keeps the keys in order.
+	indexDegree = 32
+)
+
+// indexItem is an entry of the ordered key index.
+type indexItem struct {
+	key string
+	e   *entry
+}
+
+// newIndex creates an empty ordered key index.
+func newIndex() *btree.BTreeG[indexItem] {
+	return btree.NewG(indexDegree, func(a, b indexItem) bool {
+		return a.key < b.key
+	})
+}
+
+// ScanOptions selects the keys returned by Scan.
+type ScanOptions struct {
+	// Prefix limits the scan to keys starting with it. It can't be combined with Start or End.
+	Prefix string
+	// Start is the inclusive lower bound of the scan.
+	Start string
+	// End is the exclusive upper bound of the scan. Empty means no upper bound.
+	End string
+	// Limit is the maximum number of keys returned, defaulting to 1000 and capped at 10000.
+	Limit int
+	// ContinuationToken resumes a previous scan after the last key it returned.
+	ContinuationToken string
+}
+
+// ScanItem is a single key returned by Scan.
+type ScanItem struct {
+	Key         string
+	Value       []byte
+	Version     uint64
+	ContentType string // the media type of Value, empty for opaque bytes
+}
+
+// ScanResult is a page of keys returned by Scan.
+type ScanResult struct {
+	Items []ScanItem
+	// ContinuationToken resumes the scan after the last returned key. It is empty once the scan is complete.
+	ContinuationToken string
+}
+
+// Scan returns the keys selected by opts in lexicographic order, one page at a time.
+func (kv *KeyValueStore) Scan(opts ScanOptions) (ScanResult, error) {
+	start, end, err := opts.bounds()
+	if err != nil {
+		return ScanResult{}, err
+	}
+
+	limit := opts.Limit
+	if limit <= 0 {
+		limit = defaultScanLimit
+	}
+	limit = min(limit, maxScanLimit)
+
+	kv.mu.RLock()
+	defer kv.mu.RUnlock()
+
+	now := time.Now()
+	var result ScanResult
+	visit := func(item indexItem) bool {
+		if item.e.expired(now) {
+			return true
+		}
+
+		if len(result.Items) == limit {
+			// There is at least one more key, so the scan can be resumed after the last one returned
+			result.ContinuationToken = continuationToken(result.Items[len(result.Items)-1].Key)
+			return false
+		}
+
+		result.Items = append(result.Items, item.e.item(item.key))
+		return true
+	}
+
+	if end == "" {
+		kv.index.AscendGreaterOrEqual(indexItem{key: start}, visit)
+	} else {
+		kv.index.AscendRange(indexItem{key: start}, indexItem{key: end}, visit)
+	}
+
+	return result, nil
+}
+
+// bounds returns the inclusive start and exclusive end of the scan, where an empty end is unbounded.
+func (opts ScanOptions) bounds() (string, string, error) {
+	start, end := opts.Start, opts.End
+	if opts.Prefix != "" {
+		if start != "" || end != "" {
+			return "", "", &FieldError{Field: "prefix", Err: fmt.Errorf("%w: prefix can't be combined with a start or end key", ErrInvalidScan)}
+		}
+		start, end = opts.Prefix, prefixEnd(opts.Prefix)
+	}
+
+	if opts.ContinuationToken != "" {
+		resume, err := base64.RawURLEncoding.DecodeString(opts.ContinuationToken)
+		if err != nil || string(resume) < start {
+			return "", "", &FieldError{Field: "continuation_token", Err: fmt.Errorf("%w: malformed continuation token", ErrInvalidScan)}
+		}
+		start = string(resume)
+	}
+
+	if end != "" && start > end {
+		return "", "", &FieldError{Field: "start", Err: fmt.Errorf("%w: start key is after the end key", ErrInvalidScan)}
+	}
+
+	return start, end, nil
+}
+
+// continuationToken encodes the position immediately after key.
+func continuationToken(key string) string {
+	return base64.RawURLEncoding.EncodeToString([]byte(key + "\x00"))
+}
+
+// prefixEnd returns the smallest key that is greater than every key starting with prefix,
+// or an empty string if there is no such key.
+func prefixEnd(prefix string) string {
+	end := []byte(prefix)
+	for i := len(end) - 1; i >= 0; i-- {
+		if end[i] < 0xff {
+			end[i]++
+			return string(end[:i+1])
+		}
+	}
+
+	return ""
+}
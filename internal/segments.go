@@ -0,0 +1,180 @@
+package keyvaluestore
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+)
+
+const (
+	// defaultSegmentSize is the size at which a log segment is rotated by default.
+	defaultSegmentSize = 64 << 20
+
+	// segmentDigits is how many digits are used for segment numbers in file names,
+	// which keeps the segments in order when they are listed by name.
+	segmentDigits = 8
+)
+
+// LogPosition identifies a point in the transaction log: an offset into a numbered segment.
+type LogPosition struct {
+	Segment uint64
+	Offset  int64
+}
+
+// segmentPath returns the path of log segment n.
+func (l *Logger) segmentPath(n uint64) string {
+	return filepath.Join(l.dir, fmt.Sprintf("%s-%0*d%s", l.prefix, segmentDigits, n, l.ext))
+}
+
+// listSegments returns the numbers of the log segments on disk in ascending order.
+func (l *Logger) listSegments() ([]uint64, error) {
+	paths, err := filepath.Glob(filepath.Join(l.dir, l.prefix+"-*"+l.ext))
+	if err != nil {
+		return nil, fmt.Errorf("failed to list log segments: %w", err)
+	}
+
+	var segments []uint64
+	for _, path := range paths {
+		name := strings.TrimSuffix(strings.TrimPrefix(filepath.Base(path), l.prefix+"-"), l.ext)
+		if n, parseErr := strconv.ParseUint(name, 10, 64); parseErr == nil && len(name) >= segmentDigits {
+			segments = append(segments, n)
+		}
+	}
+	slices.Sort(segments)
+
+	return segments, nil
+}
+
+// openSegment makes segment n the segment records are appended to, creating it if
+// needed, encrypted with the active key. The caller must hold l.mu unless the log
+// writer hasn't started yet.
+func (l *Logger) openSegment(n uint64) error {
+	path := l.segmentPath(n)
+	if err := prepareLogFile(path, segmentHeader(l.keys.ActiveKeyID())); err != nil {
+		return err
+	}
+
+	keyID, headerSize, err := readSegmentHeader(path)
+	if err != nil {
+		return err
+	}
+
+	file, err := os.OpenFile(path, os.O_APPEND|os.O_WRONLY, 0644)
+	if err != nil {
+		return fmt.Errorf("failed to open log segment: %w", err)
+	}
+
+	info, err := file.Stat()
+	if err != nil {
+		file.Close()
+		return fmt.Errorf("failed to stat log segment: %w", err)
+	}
+
+	l.file, l.segment, l.size, l.opened = file, n, info.Size(), time.Now()
+	l.segmentKey, l.headerSize = keyID, int64(headerSize)
+	return nil
+}
+
+// rotateIfNeeded starts a new segment if appending n bytes would take the current
+// segment past the size threshold, if the segment is older than the age threshold,
+// or if it isn't encrypted with the active key. Otherwise an empty segment is never
+// rotated. The caller must hold l.mu.
+func (l *Logger) rotateIfNeeded(n int) error {
+	rekeyed := l.segmentKey != l.keys.ActiveKeyID()
+	if l.size <= l.headerSize && !rekeyed {
+		return nil
+	}
+
+	tooLarge := l.size+int64(n) > l.opts.SegmentSize
+	tooOld := l.opts.SegmentMaxAge > 0 && time.Since(l.opened) >= l.opts.SegmentMaxAge
+	if !tooLarge && !tooOld && !rekeyed {
+		return nil
+	}
+
+	// The finished segment is fsynced first so only the last segment can have a torn tail
+	if err := l.file.Sync(); err != nil {
+		return fmt.Errorf("failed to sync log segment: %w", err)
+	}
+	if err := l.file.Close(); err != nil {
+		return fmt.Errorf("failed to close log segment: %w", err)
+	}
+
+	if err := l.openSegment(l.segment + 1); err != nil {
+		return err
+	}
+
+	return syncDir(l.dir)
+}
+
+// Position returns the position just past the last record logged so far, once
+// every queued record has been written.
+func (l *Logger) Position() (LogPosition, error) {
+	mark := <-l.markPosition()
+	return mark.position, mark.err
+}
+
+// currentPosition returns the position at the end of the current segment.
+func (l *Logger) currentPosition() LogPosition {
+	l.mu.RLock()
+	defer l.mu.RUnlock()
+
+	return LogPosition{Segment: l.segment, Offset: l.size}
+}
+
+// firstSegment returns the number of the oldest log segment on disk.
+func (l *Logger) firstSegment() (uint64, error) {
+	l.mu.RLock()
+	defer l.mu.RUnlock()
+
+	segments, err := l.listSegments()
+	if err != nil || len(segments) == 0 {
+		return l.segment, err
+	}
+
+	return segments[0], nil
+}
+
+// RemoveSegmentsBefore deletes the log segments older than segment and returns how
+// many were removed. The caller must make sure no retained snapshot needs them.
+func (l *Logger) RemoveSegmentsBefore(segment uint64) (int, error) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	segments, err := l.listSegments()
+	if err != nil {
+		return 0, err
+	}
+
+	removed := 0
+	for _, n := range segments {
+		if n >= segment || n == l.segment {
+			break
+		}
+
+		if removeErr := os.Remove(l.segmentPath(n)); removeErr != nil {
+			return removed, fmt.Errorf("failed to remove log segment: %w", removeErr)
+		}
+		removed++
+	}
+
+	return removed, nil
+}
+
+// syncDir fsyncs a directory so that files created, renamed or removed in it survive a crash.
+func syncDir(dir string) error {
+	d, err := os.Open(dir)
+	if err != nil {
+		return fmt.Errorf("failed to open directory: %w", err)
+	}
+	defer d.Close()
+
+	if err = d.Sync(); err != nil {
+		return fmt.Errorf("failed to sync directory: %w", err)
+	}
+
+	return nil
+}
@@ -1,82 +1,448 @@
-package keyvaluestore
-
-import (
-	"encoding/json"
-	"fmt"
-	"os"
-	"path/filepath"
-	"time"
-)
-
-type Snapshot struct {
-	Data      map[string]json.RawMessage `json:"data"`
-	Timestamp time.Time                  `json:"timestamp"`
-}
-
-func (kv *KeyValueStore) TakeSnapshot() error {
-	kv.mu.RLock()
-	defer kv.mu.RUnlock()
-
-	// Convert map[string][]byte to map[string]json.RawMessage
-	convertedData := make(map[string]json.RawMessage)
-	for k, v := range kv.data {
-		convertedData[k] = json.RawMessage(v)
-	}
-
-	snapshot := Snapshot{
-		Data:      convertedData,
-		Timestamp: time.Now(),
-	}
-
-	snapshotData, err := json.Marshal(snapshot)
-	if err != nil {
-		return fmt.Errorf("failed to marshal snapshot: %w", err)
-	}
-
-	snapshotFileName := fmt.Sprintf("snapshot_%s.json", snapshot.Timestamp.Format("20060102150405"))
-	snapshotFile := filepath.Join(filepath.Dir(kv.logger.filename), snapshotFileName)
-
-	if writeFileErr := os.WriteFile(snapshotFile, snapshotData, 0600); writeFileErr != nil {
-		return fmt.Errorf("failed to write snapshot file: %w", writeFileErr)
-	}
-
-	// Truncate the transaction log
-	if clearLogsErr := kv.logger.ClearLogs(); clearLogsErr != nil {
-		return fmt.Errorf("failed to clear transaction logs: %w", clearLogsErr)
-	}
-
-	return nil
-}
-
-func (kv *KeyValueStore) LoadLatestSnapshot() error {
-	snapshotDir := filepath.Dir(kv.logger.filename)
-	snapshots, listSnapshotErr := filepath.Glob(filepath.Join(snapshotDir, "snapshot_*.json"))
-	if listSnapshotErr != nil {
-		return fmt.Errorf("failed to list snapshot files: %w", listSnapshotErr)
-	}
-
-	if len(snapshots) == 0 {
-		return nil // No snapshots found, which is fine
-	}
-
-	latestSnapshot := snapshots[len(snapshots)-1]
-	snapshotData, readSnapshotErr := os.ReadFile(latestSnapshot)
-	if readSnapshotErr != nil {
-		return fmt.Errorf("failed to read snapshot file: %w", readSnapshotErr)
-	}
-
-	var snapshot Snapshot
-	if unmarshalErr := json.Unmarshal(snapshotData, &snapshot); unmarshalErr != nil {
-		return fmt.Errorf("failed to unmarshal snapshot: %w", unmarshalErr)
-	}
-
-	kv.mu.Lock()
-	defer kv.mu.Unlock()
-
-	kv.data = make(map[string][]byte)
-	for k, v := range snapshot.Data {
-		kv.data[k] = []byte(v)
-	}
-
-	return nil
-}
+package keyvaluestore
+
+import (
+	"bufio"
+	"cmp"
+	"errors"
+	"fmt"
+	"io"
+	"io/fs"
+	"log"
+	"os"
+	"path/filepath"
+	"slices"
+	"time"
+)
+
+// snapshotTimeFormat is the format of the timestamp in snapshot file names.
+const snapshotTimeFormat = "20060102150405.000"
+
+// SnapshotOptions configures how the store is snapshotted. Zero values select the defaults.
+type SnapshotOptions struct {
+	// Interval is how often a snapshot is taken. The default is one hour.
+	Interval time.Duration
+	// Compression selects how snapshot files are compressed. The default is CompressionNone.
+	Compression SnapshotCompression
+	// Retain is how many of the most recent snapshots are kept. The default is two.
+	// The transaction log is kept from the oldest retained snapshot onwards.
+	Retain int
+	// RetainFor also keeps every snapshot taken within this long. Zero disables it.
+	RetainFor time.Duration
+}
+
+// withDefaults returns opts with the default for every unset option.
+func (opts SnapshotOptions) withDefaults() SnapshotOptions {
+	if opts.Interval <= 0 {
+		opts.Interval = 1 * time.Hour
+	}
+	if opts.Compression == "" {
+		opts.Compression = CompressionNone
+	}
+	if opts.Retain <= 0 {
+		opts.Retain = 2
+	}
+
+	return opts
+}
+
+// Snapshot is the contents of the store at a point in time.
+type Snapshot struct {
+	Timestamp time.Time
+	Revision  uint64
+	Entries   []SnapshotEntry
+
+	// The position in the transaction log the snapshot covers up to
+	LogSegment uint64
+	LogOffset  int64
+
+	// KeyID is the ID of the key the snapshot file is encrypted with, empty if it isn't.
+	KeyID string
+}
+
+// SnapshotEntry is a single key in a snapshot.
+type SnapshotEntry struct {
+	Key         string
+	Value       []byte
+	ContentType string
+	Version     uint64 // zero in snapshots taken before keys were versioned
+	ExpiresAt   time.Time
+}
+
+// SnapshotInfo describes a snapshot file.
+type SnapshotInfo struct {
+	Name        string // file name within the log directory
+	Size        int64
+	Timestamp   time.Time
+	Revision    uint64
+	Keys        uint64
+	LogPosition LogPosition
+	KeyID       string // the ID of the encryption key, empty if the snapshot isn't encrypted
+	// Corrupt reports that the snapshot's header couldn't be read, in which case
+	// Timestamp is the time the file was last modified.
+	Corrupt bool
+}
+
+// TakeSnapshot writes the contents of the store to a new snapshot file and removes
+// the snapshots and log segments that are no longer needed.
+//
+// Writes are only blocked while a copy-on-write view of the store is captured,
+// which is cheap; the snapshot is built and written from the view while writes
+// continue.
+func (kv *KeyValueStore) TakeSnapshot() (SnapshotInfo, error) {
+	if kv.logger == nil {
+		return SnapshotInfo{}, ErrLoggingDisabled
+	}
+
+	// Snapshot files are only created and removed by one operation at a time
+	kv.snapshotMu.Lock()
+	defer kv.snapshotMu.Unlock()
+
+	snapshot, err := kv.captureSnapshot()
+	if err != nil {
+		return SnapshotInfo{}, err
+	}
+
+	// The log up to the snapshot's position must be durable before the snapshot is,
+	// or recovery could start replaying past the end of the log
+	if syncErr := kv.logger.enqueue(nil, true); syncErr != nil {
+		return SnapshotInfo{}, fmt.Errorf("failed to sync transaction log: %w", syncErr)
+	}
+
+	snapshotFile := kv.snapshotPath(kv.snapshotFileName(snapshot))
+
+	writeErr := writeFileAtomic(snapshotFile, 0600, func(w io.Writer) error {
+		return writeSnapshot(w, snapshot, kv.snapshotOpts.Compression, kv.keyring())
+	})
+	if writeErr != nil {
+		return SnapshotInfo{}, fmt.Errorf("failed to write snapshot file: %w", writeErr)
+	}
+
+	info, err := readSnapshotInfo(snapshotFile)
+	if err != nil {
+		return SnapshotInfo{}, err
+	}
+
+	// Only now that the snapshot is durable, drop old snapshots and the part of the
+	// transaction log that only they needed
+	if pruneErr := kv.pruneSnapshots(); pruneErr != nil {
+		return info, fmt.Errorf("failed to remove old snapshots: %w", pruneErr)
+	}
+
+	return info, nil
+}
+
+// captureSnapshot captures the contents of the store, skipping keys that have already
+// expired, together with the position in the transaction log they correspond to if
+// logging is enabled.
+func (kv *KeyValueStore) captureSnapshot() (Snapshot, error) {
+	// Changes are logged under the same lock, so the view holds exactly the changes
+	// logged before the marker
+	kv.mu.Lock()
+	view := kv.viewLocked()
+	var mark <-chan logMark
+	if kv.logger != nil {
+		mark = kv.logger.markPosition()
+	}
+	kv.mu.Unlock()
+
+	snapshot := Snapshot{Timestamp: view.taken, Revision: view.revision}
+	if mark != nil {
+		marked := <-mark
+		if marked.err != nil {
+			return Snapshot{}, fmt.Errorf("failed to get transaction log position: %w", marked.err)
+		}
+		snapshot.LogSegment, snapshot.LogOffset = marked.position.Segment, marked.position.Offset
+	}
+
+	view.ascendEntries(func(k string, e *entry) bool {
+		snapshot.Entries = append(snapshot.Entries, SnapshotEntry{
+			Key:         k,
+			Value:       e.value,
+			ContentType: e.contentType,
+			Version:     e.version,
+			ExpiresAt:   e.expiresAt,
+		})
+		return true
+	})
+
+	return snapshot, nil
+}
+
+// snapshotFileName returns the name of a new file for snapshot, made of the time and
+// revision of the snapshot. A counter is added if another snapshot of the same
+// revision was taken within the same millisecond, so that it isn't replaced. The
+// caller must hold kv.snapshotMu.
+func (kv *KeyValueStore) snapshotFileName(snapshot Snapshot) string {
+	base := fmt.Sprintf("snapshot_%s_%d", snapshot.Timestamp.UTC().Format(snapshotTimeFormat), snapshot.Revision)
+	name := base + ".snap"
+	for i := 2; ; i++ {
+		if _, err := os.Lstat(kv.snapshotPath(name)); err != nil {
+			return name
+		}
+		name = fmt.Sprintf("%s_%d.snap", base, i)
+	}
+}
+
+// pruneSnapshots removes the snapshots that are no longer retained, and then the
+// log segments that are older than the oldest remaining snapshot. The caller must
+// hold kv.snapshotMu.
+func (kv *KeyValueStore) pruneSnapshots() error {
+	// Remove temporary files left behind by snapshots that were interrupted by a crash
+	leftovers, _ := filepath.Glob(filepath.Join(kv.logger.dir, ".snapshot_*.tmp*"))
+	for _, file := range leftovers {
+		if removeErr := os.Remove(file); removeErr != nil {
+			log.Printf("Failed to remove %s: %v", file, removeErr)
+		}
+	}
+
+	snapshots, err := kv.listSnapshots()
+	if err != nil {
+		return err
+	}
+
+	// Keep the most recent snapshots and any taken within the retention period
+	now := time.Now()
+	retained := snapshots[:0]
+	for i, info := range snapshots {
+		recent := i >= len(snapshots)-kv.snapshotOpts.Retain
+		young := kv.snapshotOpts.RetainFor > 0 && now.Sub(info.Timestamp) < kv.snapshotOpts.RetainFor
+		if recent || young {
+			retained = append(retained, info)
+			continue
+		}
+
+		if removeErr := os.Remove(kv.snapshotPath(info.Name)); removeErr != nil {
+			return fmt.Errorf("failed to remove snapshot file: %w", removeErr)
+		}
+		log.Printf("Removed snapshot %s", info.Name)
+	}
+
+	if len(retained) == 0 {
+		return nil
+	}
+
+	// Keep the log if the oldest snapshot is unreadable, as recovery may have to fall back past it
+	oldest, err := readSnapshot(kv.snapshotPath(retained[0].Name), kv.keyring())
+	if err != nil {
+		log.Printf("Keeping transaction log segments: %v", err)
+		return nil
+	}
+
+	// Snapshots taken before the log was segmented don't record what they cover
+	if oldest.LogSegment == 0 {
+		return nil
+	}
+
+	removed, err := kv.logger.RemoveSegmentsBefore(oldest.LogSegment)
+	if removed > 0 {
+		log.Printf("Removed %d transaction log segments before segment %d", removed, oldest.LogSegment)
+	}
+
+	return err
+}
+
+// listSnapshots describes the snapshot files in the log directory, oldest first.
+// Snapshots in the binary format and older JSON snapshots are both listed, and they
+// are ordered by the time they were taken rather than by file name.
+func (kv *KeyValueStore) listSnapshots() ([]SnapshotInfo, error) {
+	files, err := filepath.Glob(filepath.Join(kv.logger.dir, "snapshot_*"))
+	if err != nil {
+		return nil, fmt.Errorf("failed to list snapshot files: %w", err)
+	}
+
+	var snapshots []SnapshotInfo
+	for _, file := range files {
+		if ext := filepath.Ext(file); ext != ".snap" && ext != ".json" {
+			continue
+		}
+
+		info, infoErr := readSnapshotInfo(file)
+		if errors.Is(infoErr, fs.ErrNotExist) {
+			continue // removed since it was listed
+		}
+		if infoErr != nil {
+			return nil, infoErr
+		}
+		snapshots = append(snapshots, info)
+	}
+
+	slices.SortFunc(snapshots, func(a, b SnapshotInfo) int {
+		return cmp.Or(a.Timestamp.Compare(b.Timestamp), cmp.Compare(a.Name, b.Name))
+	})
+
+	return snapshots, nil
+}
+
+// snapshotPath returns the path of the snapshot file called name.
+func (kv *KeyValueStore) snapshotPath(name string) string {
+	return filepath.Join(kv.logger.dir, name)
+}
+
+// readSnapshotInfo describes a snapshot file. Only the header of a snapshot in the
+// binary format is read, so its checksum isn't verified.
+func readSnapshotInfo(file string) (SnapshotInfo, error) {
+	stat, err := os.Stat(file)
+	if err != nil {
+		return SnapshotInfo{}, fmt.Errorf("failed to stat snapshot file: %w", err)
+	}
+
+	info := SnapshotInfo{Name: filepath.Base(file), Size: stat.Size(), Timestamp: stat.ModTime(), Corrupt: true}
+
+	var snapshot Snapshot
+	var keys uint64
+	if filepath.Ext(file) == ".json" {
+		snapshot, err = readSnapshot(file, nil)
+		keys = uint64(len(snapshot.Entries))
+	} else {
+		snapshot, keys, err = readSnapshotHeader(file)
+	}
+
+	// An unreadable snapshot is still listed, so that it can be deleted
+	if err == nil {
+		info.Timestamp = snapshot.Timestamp
+		info.Revision = snapshot.Revision
+		info.Keys = keys
+		info.LogPosition = LogPosition{Segment: snapshot.LogSegment, Offset: snapshot.LogOffset}
+		info.KeyID = snapshot.KeyID
+		info.Corrupt = false
+	}
+
+	return info, nil
+}
+
+// readSnapshotHeader reads the header of a snapshot file in the binary format and
+// returns the snapshot without its entries and the number of entries.
+func readSnapshotHeader(file string) (Snapshot, uint64, error) {
+	f, err := os.Open(file)
+	if err != nil {
+		return Snapshot{}, 0, fmt.Errorf("failed to open snapshot file: %w", err)
+	}
+	defer f.Close()
+
+	header := make([]byte, snapshotHeaderSize+1+maxKeyIDLength)
+	n, err := io.ReadFull(f, header)
+	if err != nil && !errors.Is(err, io.ErrUnexpectedEOF) {
+		return Snapshot{}, 0, fmt.Errorf("%w: failed to read header: %w", ErrCorruptSnapshot, err)
+	}
+
+	snapshot, keys, _, err := decodeSnapshotHeader(header[:n])
+	return snapshot, keys, err
+}
+
+// readSnapshot reads and decodes a snapshot file in either the binary or the JSON
+// format, decrypting it with keys if it is encrypted.
+func readSnapshot(file string, keys *Keyring) (Snapshot, error) {
+	snapshotData, readSnapshotErr := os.ReadFile(file)
+	if readSnapshotErr != nil {
+		return Snapshot{}, fmt.Errorf("failed to read snapshot file: %w", readSnapshotErr)
+	}
+
+	if filepath.Ext(file) == ".json" {
+		return decodeLegacySnapshot(snapshotData)
+	}
+
+	return decodeSnapshot(snapshotData, keys)
+}
+
+// writeFileAtomic writes a file with write so that after a crash the file is either
+// complete or left as it was. It is written to a temporary file in the same
+// directory, fsynced, renamed over name, and then the directory is fsynced.
+func writeFileAtomic(name string, perm os.FileMode, write func(w io.Writer) error) error {
+	dir := filepath.Dir(name)
+	tmp, err := os.CreateTemp(dir, "."+filepath.Base(name)+".tmp*")
+	if err != nil {
+		return err
+	}
+	defer os.Remove(tmp.Name()) // no-op once renamed
+
+	buffered := bufio.NewWriter(tmp)
+	if err = write(buffered); err == nil {
+		err = buffered.Flush()
+	}
+	if err == nil {
+		err = tmp.Chmod(perm)
+	}
+	if err == nil {
+		err = tmp.Sync()
+	}
+	if closeErr := tmp.Close(); err == nil {
+		err = closeErr
+	}
+	if err != nil {
+		return err
+	}
+
+	if err = os.Rename(tmp.Name(), name); err != nil {
+		return err
+	}
+
+	return syncDir(dir)
+}
+
+// LoadLatestSnapshot restores the store from the most recent snapshot and returns
+// the position in the transaction log that replay should continue from. A snapshot
+// that can't be read is skipped in favour of the one before it, whose part of the
+// log is still retained.
+func (kv *KeyValueStore) LoadLatestSnapshot() (LogPosition, error) {
+	position, _, err := kv.loadSnapshot(RecoveryTarget{})
+	return position, err
+}
+
+// loadSnapshot restores the store from the most recent readable snapshot taken no
+// later than target, and returns the position in the transaction log that replay
+// should continue from and whether there was such a snapshot.
+func (kv *KeyValueStore) loadSnapshot(target RecoveryTarget) (LogPosition, bool, error) {
+	snapshots, listSnapshotErr := kv.listSnapshots()
+	if listSnapshotErr != nil {
+		return LogPosition{}, false, listSnapshotErr
+	}
+
+	snapshots = slices.DeleteFunc(snapshots, func(info SnapshotInfo) bool {
+		return !info.Corrupt && !target.includes(info.Timestamp, info.Revision)
+	})
+	if len(snapshots) == 0 {
+		return LogPosition{}, false, nil // No snapshots found, which is fine
+	}
+
+	var readErr error
+	for i := len(snapshots) - 1; i >= 0; i-- {
+		var snapshot Snapshot
+		if snapshot, readErr = readSnapshot(kv.snapshotPath(snapshots[i].Name), kv.keyring()); readErr != nil {
+			log.Printf("Skipping snapshot %s: %v", snapshots[i].Name, readErr)
+			continue
+		}
+
+		return kv.restoreSnapshot(snapshot), true, nil
+	}
+
+	return LogPosition{}, false, fmt.Errorf("none of the %d snapshots could be read: %w", len(snapshots), readErr)
+}
+
+// restoreSnapshot replaces the contents of the store with snapshot and returns the
+// position in the transaction log that the snapshot covers up to.
+func (kv *KeyValueStore) restoreSnapshot(snapshot Snapshot) LogPosition {
+	kv.mu.Lock()
+	defer kv.mu.Unlock()
+
+	kv.reset()
+	kv.revision = snapshot.Revision
+	now := time.Now()
+	for _, se := range snapshot.Entries {
+		// Snapshots taken before versioning have no versions, so number their keys now
+		version := se.Version
+		if version == 0 {
+			kv.revision++
+			version = kv.revision
+		}
+
+		e := newEntry(se.Value, se.ContentType, se.ExpiresAt, version)
+		if e.expired(now) {
+			continue // expired while the server was down
+		}
+		kv.put(se.Key, e)
+	}
+
+	kv.watch.compact(kv.revision)
+
+	return LogPosition{Segment: snapshot.LogSegment, Offset: snapshot.LogOffset}
+}
@@ -0,0 +1,114 @@
+package keyvaluestore
+
+import (
+	"fmt"
+	"log"
+	"os"
+	"slices"
+)
+
+// Snapshots describes the snapshots on disk, oldest first.
+func (kv *KeyValueStore) Snapshots() ([]SnapshotInfo, error) {
+	if kv.logger == nil {
+		return nil, ErrLoggingDisabled
+	}
+
+	kv.snapshotMu.Lock()
+	defer kv.snapshotMu.Unlock()
+
+	return kv.listSnapshots()
+}
+
+// DeleteSnapshot removes the snapshot called name. It refuses with ErrSnapshotRequired
+// to remove a snapshot that recovery can't do without because the log segments
+// before it have already been removed.
+func (kv *KeyValueStore) DeleteSnapshot(name string) error {
+	if kv.logger == nil {
+		return ErrLoggingDisabled
+	}
+
+	kv.snapshotMu.Lock()
+	defer kv.snapshotMu.Unlock()
+
+	snapshots, err := kv.listSnapshots()
+	if err != nil {
+		return err
+	}
+
+	i := slices.IndexFunc(snapshots, func(info SnapshotInfo) bool { return info.Name == name })
+	if i < 0 {
+		return fmt.Errorf("%w: %s", ErrSnapshotNotFound, name)
+	}
+
+	// Recovery starts from the newest readable snapshot that is left, or from the
+	// start of the log if there is none, so that part of the log must still exist
+	needed := uint64(1)
+	remaining := slices.Delete(snapshots, i, i+1)
+	for j := len(remaining) - 1; j >= 0; j-- {
+		if !remaining[j].Corrupt && remaining[j].LogPosition.Segment != 0 {
+			needed = remaining[j].LogPosition.Segment
+			break
+		}
+	}
+
+	first, err := kv.logger.firstSegment()
+	if err != nil {
+		return err
+	}
+	if first > needed {
+		return fmt.Errorf("%w: %s", ErrSnapshotRequired, name)
+	}
+
+	if removeErr := os.Remove(kv.snapshotPath(name)); removeErr != nil {
+		return fmt.Errorf("failed to remove snapshot file: %w", removeErr)
+	}
+	log.Printf("Deleted snapshot %s", name)
+
+	return syncDir(kv.logger.dir)
+}
+
+// RestoreSnapshot replaces the contents of the store with the snapshot called name
+// and returns the store revision after the restore. The restore is logged and
+// applied as a single mutation, so watchers see every key deleted and the restored
+// keys set, which all share the new revision. A new snapshot is then taken so that
+// recovery doesn't have to replay the restore.
+func (kv *KeyValueStore) RestoreSnapshot(name string) (uint64, error) {
+	if kv.logger == nil {
+		return 0, ErrLoggingDisabled
+	}
+
+	snapshot, err := kv.readNamedSnapshot(name)
+	if err != nil {
+		return 0, err
+	}
+
+	revision, err := kv.loadEntries(snapshot.Entries, RestoreReplace)
+	if err != nil {
+		return 0, err
+	}
+	log.Printf("Restored %d keys from snapshot %s at revision %d", len(snapshot.Entries), name, revision)
+
+	if _, snapshotErr := kv.TakeSnapshot(); snapshotErr != nil {
+		return revision, fmt.Errorf("failed to take snapshot after restore: %w", snapshotErr)
+	}
+
+	return revision, nil
+}
+
+// readNamedSnapshot reads the snapshot called name, which must be one of the listed snapshots.
+func (kv *KeyValueStore) readNamedSnapshot(name string) (Snapshot, error) {
+	kv.snapshotMu.Lock()
+	defer kv.snapshotMu.Unlock()
+
+	snapshots, err := kv.listSnapshots()
+	if err != nil {
+		return Snapshot{}, err
+	}
+
+	// Only listed names are accepted, so a name can't reach outside the log directory
+	if !slices.ContainsFunc(snapshots, func(info SnapshotInfo) bool { return info.Name == name }) {
+		return Snapshot{}, fmt.Errorf("%w: %s", ErrSnapshotNotFound, name)
+	}
+
+	return readSnapshot(kv.snapshotPath(name), kv.keyring())
+}
@@ -0,0 +1,344 @@
+package keyvaluestore
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"hash/crc32"
+	"io"
+	"time"
+
+	"github.com/klauspost/compress/s2"
+	"github.com/klauspost/compress/zstd"
+)
+
+// Binary snapshots start with a fixed-size header that is never compressed:
+//
+//	magic        8 bytes  "HERDSNAP"
+//	version      uint16
+//	compression  uint8    0 none, 1 zstd, 2 snappy
+//	flags        uint8    bit 0 set if the entries are encrypted
+//	timestamp    int64    Unix nanoseconds
+//	revision     uint64
+//	log segment  uint64
+//	log offset   int64
+//	key count    uint64
+//
+// followed by the entries, compressed as a single stream if compression is
+// enabled, and a trailing CRC32 (Castagnoli) of everything before it. Every entry
+// is the length-prefixed key and value, the version, the expiry in Unix
+// nanoseconds (zero for none) and the length-prefixed content type of the value,
+// with integers encoded as varints. Integers in the header are little-endian.
+//
+// In an encrypted snapshot the header is followed by the length of the key ID as
+// one byte and the key ID, and the compressed entries are sealed with AES-GCM as a
+// whole, authenticating the header, and stored after a random nonce.
+const (
+	snapshotMagic       = "HERDSNAP"
+	snapshotVersion     = 1
+	snapshotHeaderSize  = len(snapshotMagic) + 2 + 1 + 1 + 5*8
+	snapshotTrailerSize = 4
+
+	// snapshotEncrypted is the header flag of an encrypted snapshot.
+	snapshotEncrypted = 1
+
+	// snapshotPreallocLimit is the largest key or value that is allocated in full before it is read.
+	snapshotPreallocLimit = 64 << 10
+)
+
+// SnapshotCompression selects how the entries of a snapshot file are compressed.
+type SnapshotCompression string
+
+const (
+	// CompressionNone stores entries uncompressed.
+	CompressionNone SnapshotCompression = "none"
+	// CompressionZstd compresses entries with zstd, which is smaller but slower.
+	CompressionZstd SnapshotCompression = "zstd"
+	// CompressionSnappy compresses entries with snappy, which is faster but larger.
+	CompressionSnappy SnapshotCompression = "snappy"
+)
+
+// ParseSnapshotCompression converts a compression name into a SnapshotCompression.
+func ParseSnapshotCompression(name string) (SnapshotCompression, error) {
+	switch compression := SnapshotCompression(name); compression {
+	case CompressionNone, CompressionZstd, CompressionSnappy:
+		return compression, nil
+	default:
+		return "", fmt.Errorf("unknown snapshot compression: %s", name)
+	}
+}
+
+// code returns the code the compression is stored as in a snapshot header.
+func (c SnapshotCompression) code() byte {
+	switch c {
+	case CompressionZstd:
+		return 1
+	case CompressionSnappy:
+		return 2
+	default:
+		return 0
+	}
+}
+
+// writeSnapshot encodes snapshot to w in the binary format, encrypted with the
+// active key of keys if it is set.
+func writeSnapshot(w io.Writer, snapshot Snapshot, compression SnapshotCompression, keys *Keyring) error {
+	checksum := crc32.New(crcTable())
+	buffered := bufio.NewWriter(io.MultiWriter(w, checksum))
+
+	keyID := keys.ActiveKeyID()
+	var flags byte
+	if keyID != "" {
+		flags |= snapshotEncrypted
+	}
+
+	header := make([]byte, 0, snapshotHeaderSize+1+len(keyID))
+	header = append(header, snapshotMagic...)
+	header = binary.LittleEndian.AppendUint16(header, snapshotVersion)
+	header = append(header, compression.code(), flags)
+	header = binary.LittleEndian.AppendUint64(header, uint64(snapshot.Timestamp.UnixNano()))
+	header = binary.LittleEndian.AppendUint64(header, snapshot.Revision)
+	header = binary.LittleEndian.AppendUint64(header, snapshot.LogSegment)
+	header = binary.LittleEndian.AppendUint64(header, uint64(snapshot.LogOffset))
+	header = binary.LittleEndian.AppendUint64(header, uint64(len(snapshot.Entries)))
+	if keyID != "" {
+		header = append(header, byte(len(keyID)))
+		header = append(header, keyID...)
+	}
+	if _, err := buffered.Write(header); err != nil {
+		return err
+	}
+
+	// The entries of an encrypted snapshot are sealed at once, so they are collected first
+	var plaintext bytes.Buffer
+	var out io.Writer = buffered
+	if keyID != "" {
+		out = &plaintext
+	}
+
+	body, err := compressor(out, compression)
+	if err != nil {
+		return err
+	}
+
+	var buf []byte
+	for _, e := range snapshot.Entries {
+		buf = appendBytes(buf[:0], []byte(e.Key))
+		buf = appendBytes(buf, e.Value)
+		buf = binary.AppendUvarint(buf, e.Version)
+		buf = binary.AppendVarint(buf, unixNano(e.ExpiresAt))
+		buf = appendBytes(buf, []byte(e.ContentType))
+		if _, err = body.Write(buf); err != nil {
+			return err
+		}
+	}
+
+	if err = body.Close(); err != nil {
+		return err
+	}
+
+	if keyID != "" {
+		aead, aeadErr := keys.aead(keyID)
+		if aeadErr != nil {
+			return aeadErr
+		}
+		ciphertext, sealErr := seal(aead, plaintext.Bytes(), header)
+		if sealErr != nil {
+			return sealErr
+		}
+		if _, err = buffered.Write(ciphertext); err != nil {
+			return err
+		}
+	}
+
+	if err = buffered.Flush(); err != nil {
+		return err
+	}
+
+	_, err = w.Write(binary.LittleEndian.AppendUint32(nil, checksum.Sum32()))
+	return err
+}
+
+// decodeSnapshot decodes a snapshot file in the binary format, checking its checksum
+// first and decrypting it with keys if it is encrypted.
+func decodeSnapshot(data []byte, keys *Keyring) (Snapshot, error) {
+	if len(data) < snapshotHeaderSize+snapshotTrailerSize || !bytes.HasPrefix(data, []byte(snapshotMagic)) {
+		return Snapshot{}, fmt.Errorf("%w: not a snapshot file", ErrCorruptSnapshot)
+	}
+
+	content := data[:len(data)-snapshotTrailerSize]
+	if crc32.Checksum(content, crcTable()) != binary.LittleEndian.Uint32(data[len(content):]) {
+		return Snapshot{}, fmt.Errorf("%w: checksum mismatch", ErrCorruptSnapshot)
+	}
+
+	snapshot, count, compressionCode, err := decodeSnapshotHeader(content)
+	if err != nil {
+		return Snapshot{}, err
+	}
+
+	headerSize := snapshotHeaderSize
+	compressed := content[snapshotHeaderSize:]
+	if snapshot.KeyID != "" {
+		headerSize += 1 + len(snapshot.KeyID)
+		aead, aeadErr := keys.aead(snapshot.KeyID)
+		if aeadErr != nil {
+			return Snapshot{}, aeadErr
+		}
+		if compressed, err = unseal(aead, content[headerSize:], content[:headerSize]); err != nil {
+			return Snapshot{}, fmt.Errorf("%w: failed to decrypt: %w", ErrCorruptSnapshot, err)
+		}
+	}
+
+	body, err := decompressor(bytes.NewReader(compressed), compressionCode)
+	if err != nil {
+		return Snapshot{}, err
+	}
+	defer body.Close()
+
+	r := bufio.NewReader(body)
+	snapshot.Entries = make([]SnapshotEntry, 0, min(count, uint64(len(data))))
+	for i := uint64(0); i < count; i++ {
+		e, readErr := readSnapshotEntry(r)
+		if readErr != nil {
+			return Snapshot{}, fmt.Errorf("%w: entry %d: %w", ErrCorruptSnapshot, i, readErr)
+		}
+		snapshot.Entries = append(snapshot.Entries, e)
+	}
+
+	if _, err = r.ReadByte(); !errors.Is(err, io.EOF) {
+		return Snapshot{}, fmt.Errorf("%w: trailing data after %d entries", ErrCorruptSnapshot, count)
+	}
+
+	return snapshot, nil
+}
+
+// decodeSnapshotHeader decodes the header of a snapshot file in the binary format,
+// including the key ID of an encrypted snapshot. It returns the snapshot without its
+// entries, the number of entries and the compression code.
+func decodeSnapshotHeader(data []byte) (Snapshot, uint64, byte, error) {
+	if len(data) < snapshotHeaderSize || !bytes.HasPrefix(data, []byte(snapshotMagic)) {
+		return Snapshot{}, 0, 0, fmt.Errorf("%w: not a snapshot file", ErrCorruptSnapshot)
+	}
+
+	header := data[len(snapshotMagic):snapshotHeaderSize]
+	if version := binary.LittleEndian.Uint16(header); version != snapshotVersion {
+		return Snapshot{}, 0, 0, fmt.Errorf("unsupported snapshot version %d", version)
+	}
+
+	snapshot := Snapshot{
+		Timestamp:  time.Unix(0, int64(binary.LittleEndian.Uint64(header[4:]))),
+		Revision:   binary.LittleEndian.Uint64(header[12:]),
+		LogSegment: binary.LittleEndian.Uint64(header[20:]),
+		LogOffset:  int64(binary.LittleEndian.Uint64(header[28:])),
+	}
+
+	if header[3]&snapshotEncrypted != 0 {
+		if len(data) <= snapshotHeaderSize || len(data) <= snapshotHeaderSize+int(data[snapshotHeaderSize]) {
+			return Snapshot{}, 0, 0, fmt.Errorf("%w: truncated key ID", ErrCorruptSnapshot)
+		}
+		snapshot.KeyID = string(data[snapshotHeaderSize+1 : snapshotHeaderSize+1+int(data[snapshotHeaderSize])])
+	}
+
+	return snapshot, binary.LittleEndian.Uint64(header[36:]), header[2], nil
+}
+
+// readSnapshotEntry reads a single snapshot entry.
+func readSnapshotEntry(r *bufio.Reader) (SnapshotEntry, error) {
+	key, err := readSnapshotBytes(r)
+	if err != nil {
+		return SnapshotEntry{}, err
+	}
+
+	value, err := readSnapshotBytes(r)
+	if err != nil {
+		return SnapshotEntry{}, err
+	}
+
+	keyVersion, err := binary.ReadUvarint(r)
+	if err != nil {
+		return SnapshotEntry{}, err
+	}
+
+	expiresAt, err := binary.ReadVarint(r)
+	if err != nil {
+		return SnapshotEntry{}, err
+	}
+
+	contentType, err := readSnapshotBytes(r)
+	if err != nil {
+		return SnapshotEntry{}, err
+	}
+
+	return SnapshotEntry{
+		Key:         string(key),
+		Value:       value,
+		ContentType: string(contentType),
+		Version:     keyVersion,
+		ExpiresAt:   fromUnixNano(expiresAt),
+	}, nil
+}
+
+// readSnapshotBytes reads a length-prefixed byte string.
+func readSnapshotBytes(r *bufio.Reader) ([]byte, error) {
+	length, err := binary.ReadUvarint(r)
+	if err != nil {
+		return nil, err
+	}
+
+	if length <= snapshotPreallocLimit {
+		b := make([]byte, length)
+		if _, err = io.ReadFull(r, b); err != nil {
+			return nil, io.ErrUnexpectedEOF
+		}
+		return b, nil
+	}
+
+	// Grow the buffer as data arrives rather than trusting a large length up front
+	var b bytes.Buffer
+	if _, err = io.CopyN(&b, r, int64(length)); err != nil {
+		return nil, io.ErrUnexpectedEOF
+	}
+
+	return b.Bytes(), nil
+}
+
+// compressor wraps w so that what is written to it is compressed.
+func compressor(w io.Writer, compression SnapshotCompression) (io.WriteCloser, error) {
+	switch compression {
+	case CompressionZstd:
+		return zstd.NewWriter(w)
+	case CompressionSnappy:
+		return s2.NewWriter(w, s2.WriterSnappyCompat()), nil
+	default:
+		return nopWriteCloser{w}, nil
+	}
+}
+
+// decompressor wraps r to decompress data compressed with the compression stored as code.
+func decompressor(r io.Reader, code byte) (io.ReadCloser, error) {
+	switch code {
+	case CompressionNone.code():
+		return io.NopCloser(r), nil
+	case CompressionZstd.code():
+		decoder, err := zstd.NewReader(r)
+		if err != nil {
+			return nil, err
+		}
+		return decoder.IOReadCloser(), nil
+	case CompressionSnappy.code():
+		return io.NopCloser(s2.NewReader(r)), nil
+	default:
+		return nil, fmt.Errorf("%w: unknown compression %d", ErrCorruptSnapshot, code)
+	}
+}
+
+// nopWriteCloser adds a Close method that does nothing to a writer.
+type nopWriteCloser struct {
+	io.Writer
+}
+
+func (nopWriteCloser) Close() error {
+	return nil
+}
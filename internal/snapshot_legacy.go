@@ -0,0 +1,39 @@
+package keyvaluestore
+
+import (
+	"cmp"
+	"encoding/json"
+	"fmt"
+	"slices"
+	"time"
+)
+
+// legacySnapshot is a snapshot in the JSON format used before the binary format.
+type legacySnapshot struct {
+	Data      map[string]json.RawMessage `json:"data"`
+	Timestamp time.Time                  `json:"timestamp"`
+}
+
+// decodeLegacySnapshot decodes a snapshot file in the JSON format. Its keys are
+// returned in order, so that they are numbered deterministically when restored.
+// Like the values of the text transaction log, they get no content type.
+func decodeLegacySnapshot(data []byte) (Snapshot, error) {
+	var legacy legacySnapshot
+	if unmarshalErr := json.Unmarshal(data, &legacy); unmarshalErr != nil {
+		return Snapshot{}, fmt.Errorf("%w: failed to unmarshal snapshot: %w", ErrCorruptSnapshot, unmarshalErr)
+	}
+
+	snapshot := Snapshot{
+		Timestamp: legacy.Timestamp,
+		Entries:   make([]SnapshotEntry, 0, len(legacy.Data)),
+	}
+
+	for k, v := range legacy.Data {
+		snapshot.Entries = append(snapshot.Entries, SnapshotEntry{Key: k, Value: v})
+	}
+	slices.SortFunc(snapshot.Entries, func(a, b SnapshotEntry) int {
+		return cmp.Compare(a.Key, b.Key)
+	})
+
+	return snapshot, nil
+}
@@ -0,0 +1,235 @@
+package keyvaluestore
+
+import (
+	"bytes"
+	"cmp"
+	"fmt"
+	"log"
+	"time"
+)
+
+// CompareTarget selects which part of a key a Compare looks at.
+type CompareTarget int
+
+const (
+	// CompareVersion compares the key's version, which is zero for absent keys.
+	CompareVersion CompareTarget = iota
+	// CompareValue compares the key's value. It never matches an absent key.
+	CompareValue
+)
+
+// CompareResult is the relation a Compare requires between the key and the operand.
+type CompareResult int
+
+const (
+	CompareEqual CompareResult = iota
+	CompareNotEqual
+	CompareGreater
+	CompareLess
+)
+
+// Compare is a condition on the current state of a key that guards a transaction.
+type Compare struct {
+	Key     string
+	Target  CompareTarget
+	Result  CompareResult
+	Version uint64 // operand for CompareVersion
+	Value   []byte // operand for CompareValue
+}
+
+// TxnOpType is the kind of operation run by a transaction.
+type TxnOpType int
+
+const (
+	TxnGet TxnOpType = iota
+	TxnSet
+	TxnDelete
+)
+
+// TxnOp is a single operation run by a transaction.
+type TxnOp struct {
+	Type        TxnOpType
+	Key         string
+	Value       []byte        // value for TxnSet
+	ContentType string        // optional content type of the value for TxnSet
+	TTL         time.Duration // optional TTL for TxnSet
+}
+
+// TxnOpResult is the outcome of a single transaction operation.
+// For TxnGet it holds the value read, for TxnSet the value written and
+// for TxnDelete the value that was deleted.
+type TxnOpResult struct {
+	Key         string
+	Value       []byte
+	ContentType string
+	Version     uint64
+	Found       bool // whether the key existed before a TxnSet or TxnDelete, or when it was read by TxnGet
+}
+
+// TxnResult is the outcome of a transaction.
+type TxnResult struct {
+	// Succeeded reports whether every compare held and the then operations were run.
+	Succeeded bool
+	Results   []TxnOpResult
+	// Revision is the store revision after the transaction.
+	Revision uint64
+}
+
+// Txn evaluates compares and then atomically runs thenOps if all of them hold, or elseOps otherwise.
+// The writes of a transaction are written to the transaction log as a single record and share one revision.
+// If the writes don't fit within the memory limit, nothing is applied and ErrOutOfMemory is returned.
+func (kv *KeyValueStore) Txn(compares []Compare, thenOps, elseOps []TxnOp) (TxnResult, error) {
+	kv.mu.Lock()
+	defer kv.mu.Unlock()
+
+	now := time.Now()
+	succeeded := true
+	for _, c := range compares {
+		if !kv.evaluate(c, now) {
+			succeeded = false
+			break
+		}
+	}
+
+	ops := thenOps
+	if !succeeded {
+		ops = elseOps
+	}
+
+	// Stage every operation against a view of the store that includes the transaction's
+	// earlier writes, so the writes can be checked and logged before any is applied.
+	staged := make(map[string]*entry) // a nil entry marks a deleted key
+	entries := make([]*entry, len(ops))
+	found := make([]bool, len(ops))
+	var batch []LogEntry
+	for i, op := range ops {
+		current, ok := staged[op.Key]
+		if !ok {
+			current, _ = kv.live(op.Key, now)
+		}
+		found[i] = current != nil
+
+		switch op.Type {
+		case TxnGet:
+			entries[i] = current
+		case TxnSet:
+			if err := validateValue(fmt.Sprintf("ops[%d].", i), op.Value, op.ContentType); err != nil {
+				return TxnResult{}, &KeyError{Key: op.Key, Err: err}
+			}
+			expiresAt := expiryFor(op.TTL, now)
+			entries[i] = newEntry(op.Value, op.ContentType, expiresAt, 0)
+			staged[op.Key] = entries[i]
+			batch = append(batch, LogEntry{
+				Timestamp:   now,
+				Operation:   "SET",
+				Key:         op.Key,
+				Value:       op.Value,
+				ContentType: op.ContentType,
+				ExpiresAt:   expiresAt,
+			})
+		case TxnDelete:
+			entries[i] = current
+			if current != nil {
+				staged[op.Key] = nil
+				batch = append(batch, LogEntry{
+					Timestamp:   now,
+					Operation:   "DELETE",
+					Key:         op.Key,
+					Value:       current.value,
+					ContentType: current.contentType,
+				})
+			}
+		default:
+			return TxnResult{}, invalidField(fmt.Sprintf("ops[%d]", i), "unknown transaction operation %d", op.Type)
+		}
+	}
+
+	if len(batch) > 0 {
+		if err := kv.checkLogBackpressure(); err != nil {
+			return TxnResult{}, err
+		}
+
+		if err := kv.makeRoom(func() int64 { return kv.stagedSize(staged) }); err != nil {
+			return TxnResult{}, err
+		}
+
+		// Log the whole batch before any of it is applied
+		revision, err := kv.recordMutation(LogEntry{Timestamp: now, Operation: "TXN", Batch: batch})
+		if err != nil {
+			return TxnResult{}, err
+		}
+		for i, op := range ops {
+			if op.Type == TxnSet {
+				entries[i].version = revision
+			}
+		}
+
+		for key, e := range staged {
+			if e == nil {
+				kv.remove(key)
+			} else {
+				kv.put(key, e)
+			}
+		}
+		log.Printf("Applied transaction with %d writes at revision %d", len(batch), revision)
+	}
+
+	results := make([]TxnOpResult, len(ops))
+	for i, op := range ops {
+		results[i] = TxnOpResult{Key: op.Key, Found: found[i]}
+		if entries[i] != nil {
+			results[i].Value = entries[i].value
+			results[i].ContentType = entries[i].contentType
+			results[i].Version = entries[i].version
+		}
+	}
+
+	return TxnResult{Succeeded: succeeded, Results: results, Revision: kv.revision}, nil
+}
+
+// evaluate reports whether c holds against the current state of the store.
+// The caller must hold kv.mu.
+func (kv *KeyValueStore) evaluate(c Compare, now time.Time) bool {
+	e, ok := kv.live(c.Key, now)
+
+	var order int
+	switch c.Target {
+	case CompareVersion:
+		var version uint64
+		if ok {
+			version = e.version
+		}
+		order = cmp.Compare(version, c.Version)
+	case CompareValue:
+		if !ok {
+			return false
+		}
+		order = bytes.Compare(e.value, c.Value)
+	default:
+		return false
+	}
+
+	switch c.Result {
+	case CompareEqual:
+		return order == 0
+	case CompareNotEqual:
+		return order != 0
+	case CompareGreater:
+		return order > 0
+	case CompareLess:
+		return order < 0
+	default:
+		return false
+	}
+}
+
+// stagedSize returns how many bytes applying the staged writes would add to the store.
+// The caller must hold kv.mu.
+func (kv *KeyValueStore) stagedSize(staged map[string]*entry) int64 {
+	var delta int64
+	for key, e := range staged {
+		delta += kv.sizeDelta(key, e)
+	}
+
+	return delta
+}
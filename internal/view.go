@@ -0,0 +1,65 @@
+package keyvaluestore
+
+import (
+	"time"
+
+	"github.com/google/btree"
+)
+
+// View is a consistent, read-only, point-in-time view of the store.
+// It can be read without holding the store's lock while writes continue.
+type View struct {
+	index    *btree.BTreeG[indexItem]
+	revision uint64
+	taken    time.Time
+}
+
+// View captures the current contents of the store.
+// Capturing is cheap because the ordered key index is cloned lazily with copy-on-write.
+func (kv *KeyValueStore) View() *View {
+	// Cloning updates the index's copy-on-write state, so it needs the write lock
+	kv.mu.Lock()
+	defer kv.mu.Unlock()
+
+	return kv.viewLocked()
+}
+
+// viewLocked captures the current contents of the store.
+// The caller must hold kv.mu for writing.
+func (kv *KeyValueStore) viewLocked() *View {
+	return &View{
+		index:    kv.index.Clone(),
+		revision: kv.revision,
+		taken:    time.Now(),
+	}
+}
+
+// Revision returns the store revision the view was taken at.
+func (v *View) Revision() uint64 {
+	return v.revision
+}
+
+// len returns how many keys the view holds, including keys that had expired when it was taken.
+func (v *View) len() int {
+	return v.index.Len()
+}
+
+// Ascend calls fn for every key in the view in lexicographic order until fn returns false.
+// Keys that had expired when the view was taken are skipped.
+func (v *View) Ascend(fn func(item ScanItem) bool) {
+	v.ascendEntries(func(key string, e *entry) bool {
+		return fn(e.item(key))
+	})
+}
+
+// ascendEntries calls fn for every live entry in the view in key order until fn returns false.
+// Entries are never modified once stored, so they can be read without the store's lock.
+func (v *View) ascendEntries(fn func(key string, e *entry) bool) {
+	v.index.Ascend(func(item indexItem) bool {
+		if item.e.expired(v.taken) {
+			return true
+		}
+
+		return fn(item.key, item.e)
+	})
+}
@@ -0,0 +1,197 @@
+package keyvaluestore
+
+import (
+	"fmt"
+	"strings"
+)
+
+const (
+	// watchHistorySize is how many recent events are kept for watchers that start from a past revision.
+	watchHistorySize = 1024
+
+	// watchBufferSize is how many events may be queued for a watcher before it is cancelled for being too slow.
+	watchBufferSize = 256
+)
+
+// EventType is the kind of change described by an Event.
+type EventType int
+
+const (
+	// EventSet is sent when a key is created or updated.
+	EventSet EventType = iota
+	// EventDelete is sent when a key is deleted, expires or is evicted.
+	EventDelete
+	// EventDeleteAll is sent when every key is deleted.
+	EventDeleteAll
+)
+
+// Event describes a change to the store.
+type Event struct {
+	Type  EventType
+	Key   string
+	Value []byte // the new value for EventSet, the deleted value for EventDelete if known
+	// ContentType is the media type of Value, empty for opaque bytes
+	ContentType string
+	Revision    uint64
+}
+
+// WatchFilter selects which keys a watcher receives events for.
+// If both fields are empty the watcher receives events for the whole store.
+type WatchFilter struct {
+	Key    string
+	Prefix string
+}
+
+// matches reports whether ev is relevant to the filter.
+func (f WatchFilter) matches(ev Event) bool {
+	switch {
+	case ev.Type == EventDeleteAll:
+		return true
+	case f.Key != "":
+		return ev.Key == f.Key
+	default:
+		return strings.HasPrefix(ev.Key, f.Prefix)
+	}
+}
+
+// Watcher receives the events of the store that match its filter.
+type Watcher struct {
+	kv     *KeyValueStore
+	filter WatchFilter
+	events chan Event
+	err    error
+}
+
+// Events returns the channel the watcher's events are delivered on.
+// The channel is closed when the watcher is closed or cancelled, after which Err reports why.
+func (w *Watcher) Events() <-chan Event {
+	return w.events
+}
+
+// Err returns ErrWatcherTooSlow if the watcher was cancelled because it fell behind.
+// It must only be called after the events channel has been closed.
+func (w *Watcher) Err() error {
+	return w.err
+}
+
+// Close stops the watcher and closes its events channel.
+func (w *Watcher) Close() {
+	w.kv.mu.Lock()
+	defer w.kv.mu.Unlock()
+
+	w.kv.watch.cancel(w, nil)
+}
+
+// watchHub tracks the active watchers and recent event history of a store.
+// It is protected by the store's mutex.
+type watchHub struct {
+	watchers  map[*Watcher]struct{}
+	history   []Event
+	compacted uint64 // events at or below this revision are no longer in history
+}
+
+// Watch starts watching the keys selected by filter. If startRevision is not zero, events
+// from that revision onwards are replayed from history first, or ErrCompacted is returned
+// if they are no longer available. The caller must Close the watcher when done.
+func (kv *KeyValueStore) Watch(filter WatchFilter, startRevision uint64) (*Watcher, error) {
+	kv.mu.Lock()
+	defer kv.mu.Unlock()
+
+	var backlog []Event
+	if startRevision != 0 {
+		if startRevision <= kv.watch.compacted {
+			return nil, fmt.Errorf("%w: oldest available revision is %d", ErrCompacted, kv.watch.compacted+1)
+		}
+
+		for _, ev := range kv.watch.history {
+			if ev.Revision >= startRevision && filter.matches(ev) {
+				backlog = append(backlog, ev)
+			}
+		}
+	}
+
+	// Registering under the store lock guarantees no event is missed between the backlog and live events
+	w := &Watcher{
+		kv:     kv,
+		filter: filter,
+		events: make(chan Event, len(backlog)+watchBufferSize),
+	}
+	for _, ev := range backlog {
+		w.events <- ev
+	}
+
+	if kv.watch.watchers == nil {
+		kv.watch.watchers = make(map[*Watcher]struct{})
+	}
+	kv.watch.watchers[w] = struct{}{}
+
+	return w, nil
+}
+
+// publish records events in the history and delivers them to matching watchers.
+// Watchers whose buffer is full are cancelled rather than blocking the store.
+// The caller must hold kv.mu for writing.
+func (h *watchHub) publish(events []Event) {
+	for _, ev := range events {
+		h.history = append(h.history, ev)
+		if len(h.history) > watchHistorySize {
+			h.compacted = h.history[0].Revision
+			h.history = h.history[1:]
+		}
+
+		for w := range h.watchers {
+			if !w.filter.matches(ev) {
+				continue
+			}
+
+			select {
+			case w.events <- ev:
+			default:
+				h.cancel(w, ErrWatcherTooSlow)
+			}
+		}
+	}
+}
+
+// cancel unregisters w and closes its events channel with err as the reason.
+// The caller must hold kv.mu for writing.
+func (h *watchHub) cancel(w *Watcher, err error) {
+	if _, ok := h.watchers[w]; !ok {
+		return
+	}
+
+	delete(h.watchers, w)
+	w.err = err
+	close(w.events)
+}
+
+// compact drops the event history up to and including revision, which is used
+// after the store is rebuilt from a snapshot or the transaction log.
+// The caller must hold kv.mu for writing.
+func (h *watchHub) compact(revision uint64) {
+	h.history = nil
+	h.compacted = revision
+}
+
+// eventsFor converts a transaction log entry for a mutation into the events it produces.
+func eventsFor(entry LogEntry) []Event {
+	switch entry.Operation {
+	case "SET":
+		return []Event{{Type: EventSet, Key: entry.Key, Value: entry.Value, ContentType: entry.ContentType, Revision: entry.Revision}}
+	case "DELETE":
+		return []Event{{Type: EventDelete, Key: entry.Key, Value: entry.Value, ContentType: entry.ContentType, Revision: entry.Revision}}
+	case "EXPIRE", "EVICT":
+		return []Event{{Type: EventDelete, Key: entry.Key, Revision: entry.Revision}}
+	case "DELETEALL":
+		return []Event{{Type: EventDeleteAll, Revision: entry.Revision}}
+	case "TXN":
+		var events []Event
+		for _, op := range entry.Batch {
+			op.Revision = entry.Revision
+			events = append(events, eventsFor(op)...)
+		}
+		return events
+	default:
+		return nil
+	}
+}